@@ -0,0 +1,4 @@
+data "ndfc_config_preview" "example" {
+  serial_number  = "9DBYO6WQJ46"
+  interface_name = "Ethernet1/13"
+}
@@ -0,0 +1,3 @@
+data "ndfc_fabric" "example" {
+  fabric_name = "FABRIC1"
+}
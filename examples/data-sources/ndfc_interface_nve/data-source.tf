@@ -0,0 +1,4 @@
+data "ndfc_interface_nve" "example" {
+  serial_number  = "9DBYO6WQJ46"
+  interface_name = "nve1"
+}
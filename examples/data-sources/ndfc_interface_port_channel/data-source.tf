@@ -0,0 +1,4 @@
+data "ndfc_interface_port_channel" "example" {
+  serial_number  = "9DBYO6WQJ46"
+  interface_name = "Port-channel10"
+}
@@ -0,0 +1,4 @@
+data "ndfc_interface_subinterface" "example" {
+  serial_number  = "9DBYO6WQJ46"
+  interface_name = "Ethernet1/10.100"
+}
@@ -0,0 +1,4 @@
+data "ndfc_interface_vpc" "example" {
+  serial_number  = "9DBYO6WQJ46~9RB5Y9BFNTU"
+  interface_name = "vPC10"
+}
@@ -0,0 +1,6 @@
+data "ndfc_interfaces" "example" {
+  serial_number  = "9DBYO6WQJ46"
+  policy         = "int_access_host"
+  name_regex     = "^Ethernet1/"
+  interface_type = "INTERFACE_ETHERNET"
+}
@@ -0,0 +1,5 @@
+data "ndfc_switches" "example" {
+  fabric_name    = "CML"
+  hostname_regex = "^LEAF"
+  role           = "leaf"
+}
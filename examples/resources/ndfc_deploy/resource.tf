@@ -0,0 +1,14 @@
+resource "ndfc_interface_ethernet" "example" {
+  serial_number  = "9DBYO6WQJ46"
+  interface_name = "Ethernet1/13"
+  policy         = "int_access_host"
+  access_vlan    = 500
+  deploy         = false
+}
+
+resource "ndfc_deploy" "example" {
+  interfaces = ["9DBYO6WQJ46:Ethernet1/13"]
+  triggers   = {
+    access_vlan = ndfc_interface_ethernet.example.access_vlan
+  }
+}
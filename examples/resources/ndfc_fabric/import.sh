@@ -0,0 +1 @@
+terraform import ndfc_fabric.example "FABRIC1"
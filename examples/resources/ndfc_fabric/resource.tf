@@ -0,0 +1,11 @@
+resource "ndfc_fabric" "example" {
+  fabric_name               = "FABRIC1"
+  bgp_asn                   = "65001"
+  underlay_routing_protocol = "ospf"
+  replication_mode          = "Ingress"
+  anycast_gateway_mac       = "2020.0000.00bb"
+  vrf_vlan_range            = "2000-2199"
+  network_vlan_range        = "2300-2899"
+  l2_vni_range              = "30000-39000"
+  l3_vni_range              = "50000-55000"
+}
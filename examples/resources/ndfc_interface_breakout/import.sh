@@ -0,0 +1 @@
+terraform import ndfc_interface_breakout.example "9DBYO6WQJ46:Ethernet1/49:25g-4x"
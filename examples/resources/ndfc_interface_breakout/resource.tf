@@ -0,0 +1,12 @@
+resource "ndfc_interface_breakout" "example" {
+  serial_number  = "9DBYO6WQJ46"
+  interface_name = "Ethernet1/49"
+  breakout_map   = "25g-4x"
+}
+
+resource "ndfc_interface_ethernet" "example" {
+  serial_number  = ndfc_interface_breakout.example.serial_number
+  interface_name = ndfc_interface_breakout.example.interfaces[0]
+  policy         = "int_access_host"
+  access_vlan    = 500
+}
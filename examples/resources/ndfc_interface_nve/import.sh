@@ -0,0 +1 @@
+terraform import ndfc_interface_nve.example "9DBYO6WQJ46:nve1"
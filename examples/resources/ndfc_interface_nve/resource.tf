@@ -0,0 +1,14 @@
+resource "ndfc_interface_nve" "example" {
+  serial_number                   = "9DBYO6WQJ46"
+  interface_name                  = "nve1"
+  policy                          = "nve"
+  source_interface                = "loopback1"
+  anycast_interface               = "loopback100"
+  host_reachability               = "bgp"
+  replication_mode                = "Multicast"
+  multicast_group                 = "239.1.1.1"
+  source_interface_hold_down_time = 300
+  interface_description           = "My interface description"
+  freeform_config                 = "global suppress-arp"
+  admin_state                     = false
+}
@@ -0,0 +1 @@
+terraform import ndfc_interface_port_channel.example "9DBYO6WQJ46:Port-channel10"
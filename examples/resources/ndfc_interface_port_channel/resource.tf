@@ -0,0 +1,14 @@
+resource "ndfc_interface_port_channel" "example" {
+  serial_number         = "9DBYO6WQJ46"
+  interface_name        = "Port-channel10"
+  policy                = "int_port_channel_trunk_host"
+  member_interfaces     = ["Ethernet1/10"]
+  lacp_mode             = "active"
+  bpdu_guard            = "true"
+  port_type_fast        = false
+  mtu                   = "default"
+  allowed_vlans         = "10-20"
+  interface_description = "My interface description"
+  freeform_config       = "delay 200"
+  admin_state           = false
+}
@@ -0,0 +1 @@
+terraform import ndfc_interface_subinterface.example "9DBYO6WQJ46:Ethernet1/10.100"
@@ -0,0 +1,15 @@
+resource "ndfc_interface_subinterface" "example" {
+  serial_number         = "9DBYO6WQJ46"
+  interface_name        = "Ethernet1/10.100"
+  policy                = "int_subif"
+  vlan                  = 100
+  vrf                   = "VRF1"
+  ipv4_address          = "10.1.1.1"
+  ipv4_prefix_length    = 30
+  ipv6_address          = "2001::1"
+  ipv6_prefix_length    = 64
+  mtu                   = 9000
+  interface_description = "My interface description"
+  freeform_config       = "delay 200"
+  admin_state           = false
+}
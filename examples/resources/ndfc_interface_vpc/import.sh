@@ -0,0 +1 @@
+terraform import ndfc_interface_vpc.example "9DBYO6WQJ46~9RB5Y9BFNTU:vPC10"
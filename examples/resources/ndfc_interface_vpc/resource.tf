@@ -0,0 +1,20 @@
+resource "ndfc_interface_vpc" "example" {
+  serial_number           = "9DBYO6WQJ46~9RB5Y9BFNTU"
+  interface_name          = "vPC10"
+  policy                  = "int_vpc_trunk_host"
+  peer1_port_channel_id   = 10
+  peer2_port_channel_id   = 10
+  peer1_member_interfaces = ["Ethernet1/10"]
+  peer2_member_interfaces = ["Ethernet1/10"]
+  lacp_mode               = "active"
+  bpdu_guard              = "true"
+  port_type_fast          = false
+  mtu                     = "default"
+  peer1_allowed_vlans     = "10-20"
+  peer2_allowed_vlans     = "10-20"
+  peer1_description       = "My interface description"
+  peer2_description       = "My interface description"
+  peer1_freeform_config   = "delay 200"
+  peer2_freeform_config   = "delay 200"
+  admin_state             = false
+}
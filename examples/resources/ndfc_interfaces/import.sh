@@ -0,0 +1 @@
+terraform import ndfc_interfaces.example "9DBYO6WQJ46:Ethernet1/10,9DBYO6WQJ46:Ethernet1/11,9RB5Y9BFNTU:Ethernet1/10"
@@ -0,0 +1,15 @@
+resource "ndfc_interfaces" "example" {
+  interfaces = {
+    "9DBYO6WQJ46:Ethernet1/10" = {
+      policy      = "int_access_host"
+      access_vlan = 500
+    }
+    "9DBYO6WQJ46:Ethernet1/11" = {
+      policy      = "int_access_host"
+      access_vlan = 500
+    }
+    "9RB5Y9BFNTU:Ethernet1/10" = {
+      allowed_vlans = "500-510"
+    }
+  }
+}
@@ -0,0 +1 @@
+terraform import ndfc_inventory_devices.example "CML"
@@ -0,0 +1,15 @@
+resource "ndfc_inventory_devices" "example" {
+  fabric_name     = "CML"
+  username        = "admin"
+  password        = "cisco123"
+  max_hops        = 0
+  preserve_config = false
+  devices = {
+    "10.0.0.103" = {
+      role = "leaf"
+    }
+    "10.0.0.111" = {
+      role = "border gateway"
+    }
+  }
+}
@@ -0,0 +1 @@
+terraform import ndfc_network_attachment.example "CML:NET1:9DBYO6WQJ46"
@@ -0,0 +1,7 @@
+resource "ndfc_network_attachment" "example" {
+  fabric_name         = "CML"
+  network_name        = "NET1"
+  serial_number       = "9DBYO6WQJ46"
+  vlan_id             = 2010
+  attach_switch_ports = "Ethernet1/10,Ethernet1/11"
+}
@@ -0,0 +1 @@
+terraform import ndfc_vpc_pair.example "9DBYO6WQJ46:9RB5Y9BFNTU"
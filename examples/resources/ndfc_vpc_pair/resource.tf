@@ -0,0 +1,5 @@
+resource "ndfc_vpc_pair" "example" {
+  peer1_serial_number = "9DBYO6WQJ46"
+  peer2_serial_number = "9RB5Y9BFNTU"
+  virtual_peer_link   = true
+}
@@ -0,0 +1 @@
+terraform import ndfc_vrf_attachment.example "CML:VRF1:9DBYO6WQJ46"
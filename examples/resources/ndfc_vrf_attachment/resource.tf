@@ -0,0 +1,9 @@
+resource "ndfc_vrf_attachment" "example" {
+  fabric_name     = "CML"
+  vrf_name        = "VRF1"
+  serial_number   = "9DBYO6WQJ46"
+  vlan_id         = 2000
+  freeform_config = "ip route 0.0.0.0/0 192.0.2.1"
+  loopback_id     = 101
+  loopback_ipv4   = "198.51.100.1"
+}
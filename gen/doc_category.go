@@ -39,7 +39,17 @@ type YamlConfig struct {
 
 var docPaths = []string{"./docs/data-sources/", "./docs/resources/"}
 
-var extraDocs = map[string]string{}
+var extraDocs = map[string]string{
+	"config_preview":     "Fabric",
+	"deploy":             "Fabric",
+	"interface_breakout": "Interface",
+	"interfaces":         "Interface",
+	"inventory_devices":  "Fabric",
+	"network_attachment": "Fabric",
+	"switches":           "Fabric",
+	"vpc_pair":           "Fabric",
+	"vrf_attachment":     "Fabric",
+}
 
 func SnakeCase(s string) string {
 	var g []string
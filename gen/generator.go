@@ -106,35 +106,38 @@ type YamlConfig struct {
 }
 
 type YamlConfigAttribute struct {
-	ModelName       string                `yaml:"model_name"`
-	TfName          string                `yaml:"tf_name"`
-	Type            string                `yaml:"type"`
-	ModelTypeString bool                  `yaml:"model_type_string"`
-	DataPath        []string              `yaml:"data_path"`
-	Id              bool                  `yaml:"id"`
-	Reference       bool                  `yaml:"reference"`
-	Mandatory       bool                  `yaml:"mandatory"`
-	Computed        bool                  `yaml:"computed"`
-	WriteOnly       bool                  `yaml:"write_only"`
-	TfOnly          bool                  `yaml:"tf_only"`
-	ExcludeTest     bool                  `yaml:"exclude_test"`
-	ExcludeExample  bool                  `yaml:"exclude_example"`
-	Description     string                `yaml:"description"`
-	Example         string                `yaml:"example"`
-	EnumValues      []string              `yaml:"enum_values"`
-	MinList         int64                 `yaml:"min_list"`
-	MaxList         int64                 `yaml:"max_list"`
-	MinInt          int64                 `yaml:"min_int"`
-	MaxInt          int64                 `yaml:"max_int"`
-	MinFloat        float64               `yaml:"min_float"`
-	MaxFloat        float64               `yaml:"max_float"`
-	StringPatterns  []string              `yaml:"string_patterns"`
-	StringMinLength int64                 `yaml:"string_min_length"`
-	StringMaxLength int64                 `yaml:"string_max_length"`
-	DefaultValue    string                `yaml:"default_value"`
-	Value           string                `yaml:"value"`
-	TestValue       string                `yaml:"test_value"`
-	Attributes      []YamlConfigAttribute `yaml:"attributes"`
+	ModelName         string                `yaml:"model_name"`
+	TfName            string                `yaml:"tf_name"`
+	Type              string                `yaml:"type"`
+	ModelTypeString   bool                  `yaml:"model_type_string"`
+	DataPath          []string              `yaml:"data_path"`
+	Id                bool                  `yaml:"id"`
+	Reference         bool                  `yaml:"reference"`
+	Mandatory         bool                  `yaml:"mandatory"`
+	Computed          bool                  `yaml:"computed"`
+	ReadOnly          bool                  `yaml:"read_only"`
+	RequiresReplace   bool                  `yaml:"requires_replace"`
+	WriteOnly         bool                  `yaml:"write_only"`
+	TfOnly            bool                  `yaml:"tf_only"`
+	ExcludeTest       bool                  `yaml:"exclude_test"`
+	ExcludeExample    bool                  `yaml:"exclude_example"`
+	ExcludeDataSource bool                  `yaml:"exclude_data_source"`
+	Description       string                `yaml:"description"`
+	Example           string                `yaml:"example"`
+	EnumValues        []string              `yaml:"enum_values"`
+	MinList           int64                 `yaml:"min_list"`
+	MaxList           int64                 `yaml:"max_list"`
+	MinInt            int64                 `yaml:"min_int"`
+	MaxInt            int64                 `yaml:"max_int"`
+	MinFloat          float64               `yaml:"min_float"`
+	MaxFloat          float64               `yaml:"max_float"`
+	StringPatterns    []string              `yaml:"string_patterns"`
+	StringMinLength   int64                 `yaml:"string_min_length"`
+	StringMaxLength   int64                 `yaml:"string_max_length"`
+	DefaultValue      string                `yaml:"default_value"`
+	Value             string                `yaml:"value"`
+	TestValue         string                `yaml:"test_value"`
+	Attributes        []YamlConfigAttribute `yaml:"attributes"`
 }
 
 type YamlConfigConditionalAttribute struct {
@@ -200,6 +203,16 @@ func contains(s []string, str string) bool {
 	return false
 }
 
+// Templating helper function to return true if an attribute is excluded from the data source
+func HasExcludeDataSource(attributes []YamlConfigAttribute) bool {
+	for _, attr := range attributes {
+		if attr.ExcludeDataSource {
+			return true
+		}
+	}
+	return false
+}
+
 // Templating helper function to return a list of numbers
 func Iterate(count int) []int {
 	var i int
@@ -217,12 +230,13 @@ func Increment(i int) int {
 
 // Map of templating functions
 var functions = template.FuncMap{
-	"toGoName":     ToGoName,
-	"camelCase":    CamelCase,
-	"snakeCase":    SnakeCase,
-	"hasReference": HasReference,
-	"iterate":      Iterate,
-	"increment":    Increment,
+	"toGoName":             ToGoName,
+	"camelCase":            CamelCase,
+	"snakeCase":            SnakeCase,
+	"hasReference":         HasReference,
+	"hasExcludeDataSource": HasExcludeDataSource,
+	"iterate":              Iterate,
+	"increment":            Increment,
 }
 
 func augmentAttribute(attr *YamlConfigAttribute) {
@@ -25,13 +25,14 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/netascode/go-nd"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
 	"github.com/netascode/terraform-provider-ndfc/internal/provider/helpers"
 )
 //template:end imports
@@ -49,7 +50,7 @@ func New{{camelCase .Name}}DataSource() datasource.DataSource {
 }
 
 type {{camelCase .Name}}DataSource struct {
-	client *nd.Client
+	client *ndfc.Client
 }
 
 func (d *{{camelCase .Name}}DataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -66,11 +67,12 @@ func (d *{{camelCase .Name}}DataSource) Schema(ctx context.Context, req datasour
 				MarkdownDescription: "The id of the object",
 				Computed:            true,
 			},
+			"timeouts": helpers.DataSourceTimeouts(ctx),
 			{{- range  .Attributes}}
-			{{- if not .Value}}
-			"{{.TfName}}": schema.{{if or (eq .Type "List") (eq .Type "Set")}}{{.Type}}Nested{{else if eq .Type "ListString"}}List{{else}}{{.Type}}{{end}}Attribute{
+			{{- if and (not .Value) (not .ExcludeDataSource)}}
+			"{{.TfName}}": schema.{{if or (eq .Type "List") (eq .Type "Set")}}{{.Type}}Nested{{else if eq .Type "ListString"}}List{{else if eq .Type "MapString"}}Map{{else}}{{.Type}}{{end}}Attribute{
 				MarkdownDescription: "{{.Description}}",
-				{{- if eq .Type "ListString"}}
+				{{- if or (eq .Type "ListString") (eq .Type "MapString")}}
 				ElementType:         types.StringType,
 				{{- end}}
 				{{- if or .Id .Reference}}
@@ -83,9 +85,9 @@ func (d *{{camelCase .Name}}DataSource) Schema(ctx context.Context, req datasour
 					Attributes: map[string]schema.Attribute{
 						{{- range  .Attributes}}
 						{{- if not .Value}}
-						"{{.TfName}}": schema.{{if or (eq .Type "List") (eq .Type "Set")}}{{.Type}}Nested{{else if eq .Type "ListString"}}List{{else}}{{.Type}}{{end}}Attribute{
+						"{{.TfName}}": schema.{{if or (eq .Type "List") (eq .Type "Set")}}{{.Type}}Nested{{else if eq .Type "ListString"}}List{{else if eq .Type "MapString"}}Map{{else}}{{.Type}}{{end}}Attribute{
 							MarkdownDescription: "{{.Description}}",
-							{{- if eq .Type "ListString"}}
+							{{- if or (eq .Type "ListString") (eq .Type "MapString")}}
 							ElementType:         types.StringType,
 							{{- end}}
 							{{- if or .Id .Reference}}
@@ -98,9 +100,9 @@ func (d *{{camelCase .Name}}DataSource) Schema(ctx context.Context, req datasour
 								Attributes: map[string]schema.Attribute{
 									{{- range  .Attributes}}
 									{{- if not .Value}}
-									"{{.TfName}}": schema.{{if or (eq .Type "List") (eq .Type "Set")}}{{.Type}}Nested{{else if eq .Type "ListString"}}List{{else}}{{.Type}}{{end}}Attribute{
+									"{{.TfName}}": schema.{{if or (eq .Type "List") (eq .Type "Set")}}{{.Type}}Nested{{else if eq .Type "ListString"}}List{{else if eq .Type "MapString"}}Map{{else}}{{.Type}}{{end}}Attribute{
 										MarkdownDescription: "{{.Description}}",
-										{{- if eq .Type "ListString"}}
+										{{- if or (eq .Type "ListString") (eq .Type "MapString")}}
 										ElementType:         types.StringType,
 										{{- end}}
 										{{- if or .Id .Reference}}
@@ -113,9 +115,9 @@ func (d *{{camelCase .Name}}DataSource) Schema(ctx context.Context, req datasour
 											Attributes: map[string]schema.Attribute{
 												{{- range  .Attributes}}
 												{{- if not .Value}}
-												"{{.TfName}}": schema.{{if or (eq .Type "List") (eq .Type "Set")}}{{.Type}}Nested{{else if eq .Type "ListString"}}List{{else}}{{.Type}}{{end}}Attribute{
+												"{{.TfName}}": schema.{{if or (eq .Type "List") (eq .Type "Set")}}{{.Type}}Nested{{else if eq .Type "ListString"}}List{{else if eq .Type "MapString"}}Map{{else}}{{.Type}}{{end}}Attribute{
 													MarkdownDescription: "{{.Description}}",
-													{{- if eq .Type "ListString"}}
+													{{- if or (eq .Type "ListString") (eq .Type "MapString")}}
 													ElementType:         types.StringType,
 													{{- end}}
 													{{- if or .Id .Reference}}
@@ -160,19 +162,37 @@ func (d *{{camelCase .Name}}DataSource) Configure(_ context.Context, req datasou
 //template:begin read
 func (d *{{camelCase .Name}}DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var config {{camelCase .Name}}
+{{- if hasExcludeDataSource .Attributes}}
+	// Attributes of the model that are only used by the resource
+	excluded := map[string]attr.Type{
+	{{- range .Attributes}}
+	{{- if .ExcludeDataSource}}
+		"{{.TfName}}": {{if eq .Type "ListString"}}types.ListType{ElemType: types.StringType}{{else}}types.{{.Type}}Type{{end}},
+	{{- end}}
+	{{- end}}
+	}
+{{- end}}
 
 	// Read config
-	diags := req.Config.Get(ctx, &config)
+	diags := {{if hasExcludeDataSource .Attributes}}helpers.GetDataSourceConfig(ctx, req.Config, &config, excluded){{else}}req.Config.Get(ctx, &config){{end}}
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := config.Timeouts.Read(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", config.Id.String()))
 
-	res, err := d.client.Get(fmt.Sprintf("%v%v", config.getPath(), {{range .Attributes}}{{if .Id}}config.{{toGoName .TfName}}.Value{{.Type}}(){{end}}{{end}}))
+	res, err := d.client.Get(ctx, fmt.Sprintf("%v%v", config.getPath(), {{range .Attributes}}{{if .Id}}config.{{toGoName .TfName}}.Value{{.Type}}(){{end}}{{end}}))
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object, got error: %s", err))
+		resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve object", err, nil))
 		return
 	}
 
@@ -181,7 +201,7 @@ func (d *{{camelCase .Name}}DataSource) Read(ctx context.Context, req datasource
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", config.Id.ValueString()))
 
-	diags = resp.State.Set(ctx, &config)
+	diags = {{if hasExcludeDataSource .Attributes}}helpers.SetDataSourceState(ctx, &resp.State, &config, excluded){{else}}resp.State.Set(ctx, &config){{end}}
 	resp.Diagnostics.Append(diags...)
 }
 //template:end read
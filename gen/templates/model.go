@@ -26,6 +26,8 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/netascode/terraform-provider-ndfc/internal/provider/helpers"
@@ -38,12 +40,15 @@ import (
 {{- $name := camelCase .Name}}
 type {{camelCase .Name}} struct {
 	Id types.String `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 {{- range .Attributes}}
 {{- if not .Value}}
 {{- if or (eq .Type "List") (eq .Type "Set")}}
 	{{toGoName .TfName}} []{{$name}}{{toGoName .TfName}} `tfsdk:"{{.TfName}}"`
 {{- else if or (eq .Type "ListString") (eq .Type "Versions")}}
 	{{toGoName .TfName}} types.List `tfsdk:"{{.TfName}}"`
+{{- else if eq .Type "MapString"}}
+	{{toGoName .TfName}} types.Map `tfsdk:"{{.TfName}}"`
 {{- else if eq .Type "Version"}}
 	{{toGoName .TfName}} types.Int64 `tfsdk:"{{.TfName}}"`
 {{- else}}
@@ -64,6 +69,8 @@ type {{$name}}{{toGoName .TfName}} struct {
 	{{toGoName .TfName}} []{{$name}}{{$childName}}{{toGoName .TfName}} `tfsdk:"{{.TfName}}"`
 {{- else if or (eq .Type "ListString") (eq .Type "Versions")}}
 	{{toGoName .TfName}} types.List `tfsdk:"{{.TfName}}"`
+{{- else if eq .Type "MapString"}}
+	{{toGoName .TfName}} types.Map `tfsdk:"{{.TfName}}"`
 {{- else if eq .Type "Version"}}
 	{{toGoName .TfName}} types.Int64 `tfsdk:"{{.TfName}}"`
 {{- else}}
@@ -91,6 +98,8 @@ type {{$name}}{{$childName}}{{toGoName .TfName}} struct {
 	{{toGoName .TfName}} []{{$name}}{{$childName}}{{$childChildName}}{{toGoName .TfName}} `tfsdk:"{{.TfName}}"`
 {{- else if or (eq .Type "ListString") (eq .Type "Versions")}}
 	{{toGoName .TfName}} types.List `tfsdk:"{{.TfName}}"`
+{{- else if eq .Type "MapString"}}
+	{{toGoName .TfName}} types.Map `tfsdk:"{{.TfName}}"`
 {{- else if eq .Type "Version"}}
 	{{toGoName .TfName}} types.Int64 `tfsdk:"{{.TfName}}"`
 {{- else}}
@@ -151,6 +160,18 @@ func (data {{camelCase .Name}}) getPath() string {
 }
 //template:end getPath
 
+//template:begin fieldPaths
+func (data {{camelCase .Name}}) fieldPaths() map[string]path.Path {
+	return map[string]path.Path{
+		{{- range .Attributes}}
+		{{- if and (not .Value) (not .TfOnly) (ne .Type "List") (ne .Type "Set")}}
+		"{{.ModelName}}": path.Root("{{.TfName}}"),
+		{{- end}}
+		{{- end}}
+	}
+}
+//template:end fieldPaths
+
 //template:begin toBody
 func (data {{camelCase .Name}}) toBody(ctx context.Context) string {
 	body := ""
@@ -34,11 +34,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/netascode/go-nd"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
 )
 
-const NDFC_BASEPATH = "/appcenter/cisco/ndfc/api/v1"
-
 // NdfcProvider defines the provider implementation.
 type NdfcProvider struct {
 	// version is set to the provider version on release, "dev" when the
@@ -59,7 +57,7 @@ type NdfcProviderModel struct {
 
 // NdfcProviderData describes the data maintained by the provider.
 type NdfcProviderData struct {
-	Client *nd.Client
+	Client *ndfc.Client
 	UpdateMutex *sync.Mutex
 }
 
@@ -250,8 +248,8 @@ func (p *NdfcProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		retries = config.Retries.ValueInt64()
 	}
 
-	// Create a new ND client and set it to the provider client
-	c, err := nd.NewClient(url, NDFC_BASEPATH, username, password, domain, insecure, nd.MaxRetries(int(retries)))
+	// Create a new NDFC client and set it to the provider client
+	c, err := ndfc.NewClient(url, username, password, domain, insecure, int(retries))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create client",
@@ -260,7 +258,7 @@ func (p *NdfcProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
-	data := NdfcProviderData{Client: &c, UpdateMutex: &sync.Mutex{}}
+	data := NdfcProviderData{Client: c, UpdateMutex: &sync.Mutex{}}
 	resp.DataSourceData = &data
 	resp.ResourceData = &data
 }
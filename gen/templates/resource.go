@@ -27,6 +27,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -37,7 +38,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/netascode/go-nd"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
 	"github.com/netascode/terraform-provider-ndfc/internal/provider/helpers"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -56,7 +57,7 @@ func New{{camelCase .Name}}Resource() resource.Resource {
 }
 
 type {{camelCase .Name}}Resource struct {
-	client *nd.Client
+	client *ndfc.Client
 	updateMutex *sync.Mutex
 }
 
@@ -77,9 +78,15 @@ func (r *{{camelCase .Name}}Resource) Schema(ctx context.Context, req resource.S
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 			{{- range  .Attributes}}
 			{{- if not .Value}}
-			"{{.TfName}}": schema.{{if or (eq .Type "List") (eq .Type "Set")}}{{.Type}}Nested{{else if eq .Type "ListString"}}List{{else}}{{.Type}}{{end}}Attribute{
+			"{{.TfName}}": schema.{{if or (eq .Type "List") (eq .Type "Set")}}{{.Type}}Nested{{else if eq .Type "ListString"}}List{{else if eq .Type "MapString"}}Map{{else}}{{.Type}}{{end}}Attribute{
 				MarkdownDescription: helpers.NewAttributeDescription("{{.Description}}")
 					{{- if len .EnumValues -}}
 					.AddStringEnumDescription({{range .EnumValues}}"{{.}}", {{end}})
@@ -94,15 +101,17 @@ func (r *{{camelCase .Name}}Resource) Schema(ctx context.Context, req resource.S
 					.AddDefaultValueDescription("{{.DefaultValue}}")
 					{{- end -}}
 					.String,
-				{{- if eq .Type "ListString"}}
+				{{- if or (eq .Type "ListString") (eq .Type "MapString")}}
 				ElementType:         types.StringType,
 				{{- end}}
-				{{- if .Mandatory}}
+				{{- if .ReadOnly}}
+				Computed:            true,
+				{{- else if .Mandatory}}
 				Required:            true,
 				{{- else}}
 				Optional:            true,
 				{{- end}}
-				{{- if or (len .DefaultValue) .Computed}}
+				{{- if and (not .ReadOnly) (or (len .DefaultValue) .Computed)}}
 				Computed:            true,
 				{{- end}}
 				{{- if len .EnumValues}}
@@ -134,12 +143,17 @@ func (r *{{camelCase .Name}}Resource) Schema(ctx context.Context, req resource.S
 				{{- else if and (len .DefaultValue) (eq .Type "String")}}
 				Default:             stringdefault.StaticString("{{.DefaultValue}}"),
 				{{- end}}
+				{{- if .RequiresReplace}}
+				PlanModifiers: []planmodifier.{{.Type}}{
+					{{snakeCase .Type}}planmodifier.RequiresReplace(),
+				},
+				{{- end}}
 				{{- if or (eq .Type "List") (eq .Type "Set")}}
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						{{- range  .Attributes}}
 						{{- if not .Value}}
-						"{{.TfName}}": schema.{{if or (eq .Type "List") (eq .Type "Set")}}{{.Type}}Nested{{else if eq .Type "ListString"}}List{{else}}{{.Type}}{{end}}Attribute{
+						"{{.TfName}}": schema.{{if or (eq .Type "List") (eq .Type "Set")}}{{.Type}}Nested{{else if eq .Type "ListString"}}List{{else if eq .Type "MapString"}}Map{{else}}{{.Type}}{{end}}Attribute{
 							MarkdownDescription: helpers.NewAttributeDescription("{{.Description}}")
 								{{- if len .EnumValues -}}
 								.AddStringEnumDescription({{range .EnumValues}}"{{.}}", {{end}})
@@ -154,7 +168,7 @@ func (r *{{camelCase .Name}}Resource) Schema(ctx context.Context, req resource.S
 								.AddDefaultValueDescription("{{.DefaultValue}}")
 								{{- end -}}
 								.String,
-							{{- if eq .Type "ListString"}}
+							{{- if or (eq .Type "ListString") (eq .Type "MapString")}}
 							ElementType:         types.StringType,
 							{{- end}}
 							{{- if .Mandatory}}
@@ -199,7 +213,7 @@ func (r *{{camelCase .Name}}Resource) Schema(ctx context.Context, req resource.S
 								Attributes: map[string]schema.Attribute{
 									{{- range  .Attributes}}
 									{{- if not .Value}}
-									"{{.TfName}}": schema.{{if or (eq .Type "List") (eq .Type "Set")}}{{.Type}}Nested{{else if eq .Type "ListString"}}List{{else}}{{.Type}}{{end}}Attribute{
+									"{{.TfName}}": schema.{{if or (eq .Type "List") (eq .Type "Set")}}{{.Type}}Nested{{else if eq .Type "ListString"}}List{{else if eq .Type "MapString"}}Map{{else}}{{.Type}}{{end}}Attribute{
 										MarkdownDescription: helpers.NewAttributeDescription("{{.Description}}")
 											{{- if len .EnumValues -}}
 											.AddStringEnumDescription({{range .EnumValues}}"{{.}}", {{end}})
@@ -214,7 +228,7 @@ func (r *{{camelCase .Name}}Resource) Schema(ctx context.Context, req resource.S
 											.AddDefaultValueDescription("{{.DefaultValue}}")
 											{{- end -}}
 											.String,
-										{{- if eq .Type "ListString"}}
+										{{- if or (eq .Type "ListString") (eq .Type "MapString")}}
 										ElementType:         types.StringType,
 										{{- end}}
 										{{- if .Mandatory}}
@@ -259,7 +273,7 @@ func (r *{{camelCase .Name}}Resource) Schema(ctx context.Context, req resource.S
 											Attributes: map[string]schema.Attribute{
 												{{- range  .Attributes}}
 												{{- if not .Value}}
-												"{{.TfName}}": schema.{{if or (eq .Type "List") (eq .Type "Set")}}{{.Type}}Nested{{else if eq .Type "ListString"}}List{{else}}{{.Type}}{{end}}Attribute{
+												"{{.TfName}}": schema.{{if or (eq .Type "List") (eq .Type "Set")}}{{.Type}}Nested{{else if eq .Type "ListString"}}List{{else if eq .Type "MapString"}}Map{{else}}{{.Type}}{{end}}Attribute{
 													MarkdownDescription: helpers.NewAttributeDescription("{{.Description}}")
 														{{- if len .EnumValues -}}
 														.AddStringEnumDescription({{range .EnumValues}}"{{.}}", {{end}})
@@ -274,7 +288,7 @@ func (r *{{camelCase .Name}}Resource) Schema(ctx context.Context, req resource.S
 														.AddDefaultValueDescription("{{.DefaultValue}}")
 														{{- end -}}
 														.String,
-													{{- if eq .Type "ListString"}}
+													{{- if or (eq .Type "ListString") (eq .Type "MapString")}}
 													ElementType:         types.StringType,
 													{{- end}}
 													{{- if .Mandatory}}
@@ -390,22 +404,30 @@ func (r *{{camelCase .Name}}Resource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
 
 	// Create object
 	body := plan.toBody(ctx)
 
-	res, err := r.client.Post(plan.getPath(), body)
+	_, err := r.client.Post(ctx, plan.getPath(), body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to configure object (POST), got error: %s, %s", err, res.String()))
+		resp.Diagnostics.Append(helpers.ClientError("Failed to configure object (POST)", err, plan.fieldPaths()))
 		return
 	}
 
 	plan.Id = types.StringValue({{$first := true}}{{range .Attributes}}{{if or .Id .Reference}}{{if not $first}}+"/"+{{end}}{{$first = false}}plan.{{toGoName .TfName}}.Value{{.Type}}(){{end}}{{end}})
 
-	res, err = r.client.Get(fmt.Sprintf("%v%v", plan.getPath(), {{range .Attributes}}{{if .Id}}plan.{{toGoName .TfName}}.Value{{.Type}}(){{end}}{{end}}))
+	res, err := r.client.Get(ctx, fmt.Sprintf("%v%v", plan.getPath(), {{range .Attributes}}{{if .Id}}plan.{{toGoName .TfName}}.Value{{.Type}}(){{end}}{{end}}))
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, res.String()))
+		resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve object (GET)", err, nil))
 		return
 	}
 
@@ -429,15 +451,23 @@ func (r *{{camelCase .Name}}Resource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
 
-	res, err := r.client.Get(fmt.Sprintf("%v%v", state.getPath(), {{range .Attributes}}{{if .Id}}state.{{toGoName .TfName}}.Value{{.Type}}(){{end}}{{end}}))
+	res, err := r.client.Get(ctx, fmt.Sprintf("%v%v", state.getPath(), {{range .Attributes}}{{if .Id}}state.{{toGoName .TfName}}.Value{{.Type}}(){{end}}{{end}}))
 	if err != nil {
-		if strings.Contains(err.Error(), "StatusCode 400") || strings.Contains(err.Error(), "StatusCode 500") {
+		if ndfc.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		} else {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, res.String()))
+			resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve object (GET)", err, nil))
 			return
 		}
 	}
@@ -462,12 +492,20 @@ func (r *{{camelCase .Name}}Resource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
 
 	body := plan.toBody(ctx)
-	res, err := r.client.Put(fmt.Sprintf("%v%v", plan.getPath(), {{range .Attributes}}{{if .Id}}plan.{{toGoName .TfName}}.Value{{.Type}}(){{end}}{{end}}), body)
+	_, err := r.client.Put(ctx, fmt.Sprintf("%v%v", plan.getPath(), {{range .Attributes}}{{if .Id}}plan.{{toGoName .TfName}}.Value{{.Type}}(){{end}}{{end}}), body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to configure object (PUT), got error: %s, %s", err, res.String()))
+		resp.Diagnostics.Append(helpers.ClientError("Failed to configure object (PUT)", err, plan.fieldPaths()))
 		return
 	}
 
@@ -489,11 +527,19 @@ func (r *{{camelCase .Name}}Resource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
 
-	res, err := r.client.Delete(fmt.Sprintf("%v%v", state.getPath(), {{range .Attributes}}{{if .Id}}state.{{toGoName .TfName}}.Value{{.Type}}(){{end}}{{end}}), "")
+	_, err := r.client.Delete(ctx, fmt.Sprintf("%v%v", state.getPath(), {{range .Attributes}}{{if .Id}}state.{{toGoName .TfName}}.Value{{.Type}}(){{end}}{{end}}), "")
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to delete object (DELETE), got error: %s, %s", err, res.String()))
+		resp.Diagnostics.Append(helpers.ClientError("Failed to delete object (DELETE)", err, nil))
 		return
 	}
 
@@ -0,0 +1,153 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/helpers"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &ConfigPreviewDataSource{}
+	_ datasource.DataSourceWithConfigure = &ConfigPreviewDataSource{}
+)
+
+func NewConfigPreviewDataSource() datasource.DataSource {
+	return &ConfigPreviewDataSource{}
+}
+
+type ConfigPreviewDataSource struct {
+	client *ndfc.Client
+}
+
+func (d *ConfigPreviewDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_preview"
+}
+
+func (d *ConfigPreviewDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This data source can read the NX-OS configuration NDFC would push to a switch with its next deployment, optionally limited to a VRF, network or interface.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The id of the object",
+				Computed:            true,
+			},
+			"timeouts": helpers.DataSourceTimeouts(ctx),
+			"serial_number": schema.StringAttribute{
+				MarkdownDescription: "Serial number of the switch",
+				Required:            true,
+			},
+			"vrf_name": schema.StringAttribute{
+				MarkdownDescription: "Only return the configuration of this VRF, conflicts with `network_name` and `interface_name`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("network_name"), path.MatchRoot("interface_name")),
+				},
+			},
+			"network_name": schema.StringAttribute{
+				MarkdownDescription: "Only return the configuration of this network, conflicts with `vrf_name` and `interface_name`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("interface_name")),
+				},
+			},
+			"interface_name": schema.StringAttribute{
+				MarkdownDescription: "Only return the configuration of this interface, conflicts with `vrf_name` and `network_name`. Example: `Ethernet1/10`",
+				Optional:            true,
+			},
+			"config": schema.StringAttribute{
+				MarkdownDescription: "Pending configuration, empty if there is nothing to deploy",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ConfigPreviewDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*NdfcProviderData).Client
+}
+
+func (d *ConfigPreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ConfigPreview
+
+	// Read config
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := config.Timeouts.Read(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	config.Id = types.StringValue(config.getId())
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", config.Id.ValueString()))
+
+	serial := config.SerialNumber.ValueString()
+	fabric, err := d.client.GetSwitchFabric(ctx, serial)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ClientError(fmt.Sprintf("Failed to look up fabric of switch %s", serial), err, nil))
+		return
+	}
+
+	var preview string
+	switch {
+	case !config.VrfName.IsNull():
+		preview, err = d.client.GetPreview(ctx, ndfc.VRFType, fabric, config.VrfName.ValueString(), serial)
+	case !config.NetworkName.IsNull():
+		preview, err = d.client.GetPreview(ctx, ndfc.NetworkType, fabric, config.NetworkName.ValueString(), serial)
+	default:
+		preview, err = d.client.GetSwitchPreview(ctx, fabric, serial)
+		if err == nil && !config.InterfaceName.IsNull() {
+			preview = ndfc.InterfaceConfig(preview, config.InterfaceName.ValueString())
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve config preview", err, nil))
+		return
+	}
+	config.Config = types.StringValue(preview)
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", config.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
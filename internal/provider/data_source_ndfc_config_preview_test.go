@@ -0,0 +1,58 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceNdfcConfigPreview(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNdfcConfigPreviewConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ndfc_config_preview.test", "id", "9DBYO6WQJ46:Ethernet1/13"),
+					resource.TestMatchResourceAttr("data.ndfc_config_preview.test", "config", regexp.MustCompile(`^interface Ethernet1/13\n(.*\n)*  switchport access vlan 500\n`)),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceNdfcConfigPreviewConfig = `
+
+resource "ndfc_interface_ethernet" "test" {
+	serial_number = "9DBYO6WQJ46"
+	interface_name = "Ethernet1/13"
+	policy = "int_access_host"
+	access_vlan = 500
+	deploy = false
+}
+
+data "ndfc_config_preview" "test" {
+	serial_number = "9DBYO6WQJ46"
+	interface_name = "Ethernet1/13"
+	depends_on = [ndfc_interface_ethernet.test]
+}
+`
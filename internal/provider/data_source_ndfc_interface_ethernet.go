@@ -24,11 +24,13 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/netascode/go-nd"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/helpers"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
 )
 
 //template:end imports
@@ -46,7 +48,7 @@ func NewInterfaceEthernetDataSource() datasource.DataSource {
 }
 
 type InterfaceEthernetDataSource struct {
-	client *nd.Client
+	client *ndfc.Client
 }
 
 func (d *InterfaceEthernetDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -63,6 +65,7 @@ func (d *InterfaceEthernetDataSource) Schema(ctx context.Context, req datasource
 				MarkdownDescription: "The id of the object",
 				Computed:            true,
 			},
+			"timeouts": helpers.DataSourceTimeouts(ctx),
 			"serial_number": schema.StringAttribute{
 				MarkdownDescription: "Serial number of switch to configure",
 				Required:            true,
@@ -75,6 +78,10 @@ func (d *InterfaceEthernetDataSource) Schema(ctx context.Context, req datasource
 				MarkdownDescription: "Name of the policy. Examples: `int_trunk_host`, `int_access_host`",
 				Computed:            true,
 			},
+			"deploy": schema.BoolAttribute{
+				MarkdownDescription: "Deploy the configuration to the switch. If `false`, changes are only staged in NDFC and can be deployed later, e.g. with `ndfc_deploy`",
+				Computed:            true,
+			},
 			"bpdu_guard": schema.StringAttribute{
 				MarkdownDescription: "Enable spanning-tree bpduguard: true='enable', false='disable', no='return to default settings'",
 				Computed:            true,
@@ -151,19 +158,31 @@ func (d *InterfaceEthernetDataSource) Configure(_ context.Context, req datasourc
 
 func (d *InterfaceEthernetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var config InterfaceEthernet
+	// Attributes of the model that are only used by the resource
+	excluded := map[string]attr.Type{
+		"destroy_policy": types.StringType,
+	}
 
 	// Read config
-	diags := req.Config.Get(ctx, &config)
+	diags := helpers.GetDataSourceConfig(ctx, req.Config, &config, excluded)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := config.Timeouts.Read(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", config.Id.String()))
 
-	res, err := d.client.Get(fmt.Sprintf("%v?serialNumber=%v&ifName=%v", config.getPath(), config.SerialNumber.ValueString(), config.InterfaceName.ValueString()))
+	res, err := d.client.Get(ctx, fmt.Sprintf("%v?serialNumber=%v&ifName=%v", config.getPath(), config.SerialNumber.ValueString(), config.InterfaceName.ValueString()))
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object, got error: %s", err))
+		resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve object", err, nil))
 		return
 	}
 
@@ -172,6 +191,6 @@ func (d *InterfaceEthernetDataSource) Read(ctx context.Context, req datasource.R
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", config.Id.ValueString()))
 
-	diags = resp.State.Set(ctx, &config)
+	diags = helpers.SetDataSourceState(ctx, &resp.State, &config, excluded)
 	resp.Diagnostics.Append(diags...)
 }
@@ -28,7 +28,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/netascode/go-nd"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/helpers"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
 )
 
 //template:end imports
@@ -46,7 +47,7 @@ func NewInterfaceLoopbackDataSource() datasource.DataSource {
 }
 
 type InterfaceLoopbackDataSource struct {
-	client *nd.Client
+	client *ndfc.Client
 }
 
 func (d *InterfaceLoopbackDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -63,6 +64,7 @@ func (d *InterfaceLoopbackDataSource) Schema(ctx context.Context, req datasource
 				MarkdownDescription: "The id of the object",
 				Computed:            true,
 			},
+			"timeouts": helpers.DataSourceTimeouts(ctx),
 			"serial_number": schema.StringAttribute{
 				MarkdownDescription: "Serial number of switch to configure",
 				Required:            true,
@@ -75,6 +77,10 @@ func (d *InterfaceLoopbackDataSource) Schema(ctx context.Context, req datasource
 				MarkdownDescription: "Name of the policy. Examples: `int_loopback`, `int_multisite_loopback`, `int_freeform`",
 				Computed:            true,
 			},
+			"deploy": schema.BoolAttribute{
+				MarkdownDescription: "Deploy the configuration to the switch. If `false`, changes are only staged in NDFC and can be deployed later, e.g. with `ndfc_deploy`",
+				Computed:            true,
+			},
 			"vrf": schema.StringAttribute{
 				MarkdownDescription: "Interface VRF name, default VRF if not specified",
 				Computed:            true,
@@ -127,11 +133,19 @@ func (d *InterfaceLoopbackDataSource) Read(ctx context.Context, req datasource.R
 		return
 	}
 
+	readTimeout, diags := config.Timeouts.Read(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", config.Id.String()))
 
-	res, err := d.client.Get(fmt.Sprintf("%v?serialNumber=%v&ifName=%v", config.getPath(), config.SerialNumber.ValueString(), config.InterfaceName.ValueString()))
+	res, err := d.client.Get(ctx, fmt.Sprintf("%v?serialNumber=%v&ifName=%v", config.getPath(), config.SerialNumber.ValueString(), config.InterfaceName.ValueString()))
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object, got error: %s", err))
+		resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve object", err, nil))
 		return
 	}
 
@@ -0,0 +1,197 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/helpers"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &InterfacesDataSource{}
+	_ datasource.DataSourceWithConfigure = &InterfacesDataSource{}
+)
+
+func NewInterfacesDataSource() datasource.DataSource {
+	return &InterfacesDataSource{}
+}
+
+type InterfacesDataSource struct {
+	client *ndfc.Client
+}
+
+func (d *InterfacesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_interfaces"
+}
+
+func (d *InterfacesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This data source can read all interfaces of a switch or fabric, keyed by `<serial_number>:<interface_name>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The id of the object",
+				Computed:            true,
+			},
+			"timeouts": helpers.DataSourceTimeouts(ctx),
+			"fabric_name": schema.StringAttribute{
+				MarkdownDescription: "Return the interfaces of all switches of this fabric, conflicts with `serial_number`",
+				Optional:            true,
+			},
+			"serial_number": schema.StringAttribute{
+				MarkdownDescription: "Return the interfaces of the switch with this serial number, conflicts with `fabric_name`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("fabric_name")),
+				},
+			},
+			"policy": schema.StringAttribute{
+				MarkdownDescription: "Only return interfaces with this policy, e.g. `int_access_host`",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return interfaces whose name matches this regular expression",
+				Optional:            true,
+			},
+			"interface_type": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Only return interfaces of this type").AddStringEnumDescription("INTERFACE_ETHERNET", "INTERFACE_PORT_CHANNEL", "INTERFACE_VPC", "INTERFACE_LOOPBACK", "INTERFACE_VLAN", "INTERFACE_NVE", "SUBINTERFACE").String,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("INTERFACE_ETHERNET", "INTERFACE_PORT_CHANNEL", "INTERFACE_VPC", "INTERFACE_LOOPBACK", "INTERFACE_VLAN", "INTERFACE_NVE", "SUBINTERFACE"),
+				},
+			},
+			"interfaces": schema.MapNestedAttribute{
+				MarkdownDescription: "Interfaces, keyed by `<serial_number>:<interface_name>`",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"serial_number": schema.StringAttribute{
+							MarkdownDescription: "Serial number of the switch, the serial numbers of both peers separated by `~` for vPCs",
+							Computed:            true,
+						},
+						"interface_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the interface",
+							Computed:            true,
+						},
+						"interface_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the interface, e.g. `INTERFACE_ETHERNET`",
+							Computed:            true,
+						},
+						"policy": schema.StringAttribute{
+							MarkdownDescription: "Name of the policy",
+							Computed:            true,
+						},
+						"admin_state": schema.BoolAttribute{
+							MarkdownDescription: "Whether the interface is administratively up",
+							Computed:            true,
+						},
+						"oper_state": schema.StringAttribute{
+							MarkdownDescription: "Operational state of the interface, e.g. `up` or `down`",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Interface description",
+							Computed:            true,
+						},
+						"vrf": schema.StringAttribute{
+							MarkdownDescription: "VRF of the interface",
+							Computed:            true,
+						},
+						"allowed_vlans": schema.StringAttribute{
+							MarkdownDescription: "Allowed VLANs of trunk ports, the access VLAN of access ports",
+							Computed:            true,
+						},
+						"native_vlan": schema.Int64Attribute{
+							MarkdownDescription: "Native VLAN of trunk ports",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *InterfacesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*NdfcProviderData).Client
+}
+
+func (d *InterfacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config InterfaceList
+
+	// Read config
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := config.Timeouts.Read(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", config.Id.String()))
+
+	var re *regexp.Regexp
+	if !config.NameRegex.IsNull() {
+		var err error
+		re, err = regexp.Compile(config.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Attribute Value", fmt.Sprintf("Invalid regular expression: %s", err))
+			return
+		}
+	}
+
+	details, err := d.client.GetInterfaceDetails(ctx, config.FabricName.ValueString(), config.SerialNumber.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve object", err, nil))
+		return
+	}
+
+	config.fromInterfaceDetails(details, re)
+	config.Id = types.StringValue(config.FabricName.ValueString())
+	if !config.SerialNumber.IsNull() {
+		config.Id = types.StringValue(config.SerialNumber.ValueString())
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", config.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
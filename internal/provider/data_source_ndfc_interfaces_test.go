@@ -0,0 +1,62 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceNdfcInterfaces(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNdfcInterfacesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ndfc_interfaces.test", "interfaces.%", "1"),
+					resource.TestCheckResourceAttr("data.ndfc_interfaces.test", "interfaces.9DBYO6WQJ46:Ethernet1/30.interface_type", "INTERFACE_ETHERNET"),
+					resource.TestCheckResourceAttr("data.ndfc_interfaces.test", "interfaces.9DBYO6WQJ46:Ethernet1/30.allowed_vlans", "500"),
+					resource.TestCheckResourceAttr("data.ndfc_interfaces.test", "interfaces.9DBYO6WQJ46:Ethernet1/30.description", "server"),
+					resource.TestCheckResourceAttr("data.ndfc_interfaces.test", "interfaces.9DBYO6WQJ46:Ethernet1/30.admin_state", "true"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceNdfcInterfacesConfig = `
+
+resource "ndfc_interface_ethernet" "test" {
+	serial_number = "9DBYO6WQJ46"
+	interface_name = "Ethernet1/30"
+	policy = "int_access_host"
+	access_vlan = 500
+	interface_description = "server"
+}
+
+data "ndfc_interfaces" "test" {
+	serial_number = "9DBYO6WQJ46"
+	policy = "int_access_host"
+	name_regex = "^Ethernet1/3"
+	interface_type = "INTERFACE_ETHERNET"
+	depends_on = [ndfc_interface_ethernet.test]
+}
+`
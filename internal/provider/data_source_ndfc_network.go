@@ -24,11 +24,13 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/netascode/go-nd"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/helpers"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
 )
 
 //template:end imports
@@ -46,7 +48,7 @@ func NewNetworkDataSource() datasource.DataSource {
 }
 
 type NetworkDataSource struct {
-	client *nd.Client
+	client *ndfc.Client
 }
 
 func (d *NetworkDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -63,6 +65,7 @@ func (d *NetworkDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				MarkdownDescription: "The id of the object",
 				Computed:            true,
 			},
+			"timeouts": helpers.DataSourceTimeouts(ctx),
 			"fabric_name": schema.StringAttribute{
 				MarkdownDescription: "The name of the fabric",
 				Required:            true,
@@ -196,7 +199,7 @@ func (d *NetworkDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				Computed:            true,
 			},
 			"attachments": schema.SetNestedAttribute{
-				MarkdownDescription: "A list of attachments",
+				MarkdownDescription: "A list of attachments. Switches attached outside of Terraform show up as a diff, except for those in `unmanaged_attachments`. If not set, the attachments of the network are not managed",
 				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -223,6 +226,11 @@ func (d *NetworkDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 					},
 				},
 			},
+			"attachment_status": schema.MapAttribute{
+				MarkdownDescription: "Deployment state of the network on the switches it is attached to, keyed by serial number. A switch that is not `DEPLOYED` is deployed again on the next apply. Example: `OUT-OF-SYNC`",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -239,33 +247,45 @@ func (d *NetworkDataSource) Configure(_ context.Context, req datasource.Configur
 
 func (d *NetworkDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var config Network
+	// Attributes of the model that are only used by the resource
+	excluded := map[string]attr.Type{
+		"unmanaged_attachments": types.ListType{ElemType: types.StringType},
+	}
 
 	// Read config
-	diags := req.Config.Get(ctx, &config)
+	diags := helpers.GetDataSourceConfig(ctx, req.Config, &config, excluded)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := config.Timeouts.Read(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", config.Id.String()))
 
-	res, err := d.client.Get(fmt.Sprintf("%v%v", config.getPath(), config.NetworkName.ValueString()))
+	res, err := d.client.Get(ctx, fmt.Sprintf("%v%v", config.getPath(), config.NetworkName.ValueString()))
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object, got error: %s", err))
+		resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve object", err, nil))
 		return
 	}
 	config.fromBody(ctx, res)
 	config.Id = types.StringValue(config.FabricName.ValueString() + "/" + config.NetworkName.ValueString())
 
-	res, err = d.client.Get(fmt.Sprintf("%vattachments?network-names=%v", config.getPath(), config.NetworkName.ValueString()))
+	res, err = d.client.Get(ctx, fmt.Sprintf("%vattachments?network-names=%v", config.getPath(), config.NetworkName.ValueString()))
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve network attachments, got error: %s, %s", err, res.String()))
+		resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve network attachments", err, nil))
 		return
 	}
-	config.fromBodyAttachments(ctx, res, true)
+	config.fromBodyAttachments(ctx, res)
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", config.Id.ValueString()))
 
-	diags = resp.State.Set(ctx, &config)
+	diags = helpers.SetDataSourceState(ctx, &resp.State, &config, excluded)
 	resp.Diagnostics.Append(diags...)
 }
@@ -0,0 +1,169 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/helpers"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &SwitchesDataSource{}
+	_ datasource.DataSourceWithConfigure = &SwitchesDataSource{}
+)
+
+func NewSwitchesDataSource() datasource.DataSource {
+	return &SwitchesDataSource{}
+}
+
+type SwitchesDataSource struct {
+	client *ndfc.Client
+}
+
+func (d *SwitchesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_switches"
+}
+
+func (d *SwitchesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This data source can read the switches of a fabric, keyed by hostname, or by serial number if the hostname is not set.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The id of the object",
+				Computed:            true,
+			},
+			"timeouts": helpers.DataSourceTimeouts(ctx),
+			"fabric_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the fabric",
+				Required:            true,
+			},
+			"hostname_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return switches whose hostname matches this regular expression",
+				Optional:            true,
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Only return switches with this role, e.g. `leaf` or `border gateway`",
+				Optional:            true,
+			},
+			"switches": schema.MapNestedAttribute{
+				MarkdownDescription: "Switches of the fabric, keyed by hostname, or by serial number if the hostname is not set. Switches with the same hostname are reported as an error",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"serial_number": schema.StringAttribute{
+							MarkdownDescription: "Serial number of the switch",
+							Computed:            true,
+						},
+						"ip_address": schema.StringAttribute{
+							MarkdownDescription: "Management IP address of the switch",
+							Computed:            true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "Role of the switch",
+							Computed:            true,
+						},
+						"model": schema.StringAttribute{
+							MarkdownDescription: "Hardware model of the switch",
+							Computed:            true,
+						},
+						"version": schema.StringAttribute{
+							MarkdownDescription: "Software version of the switch",
+							Computed:            true,
+						},
+						"vpc_peer_serial_number": schema.StringAttribute{
+							MarkdownDescription: "Serial number of the vPC peer, if the switch is part of a vPC pair",
+							Computed:            true,
+						},
+						"vpc_peer_hostname": schema.StringAttribute{
+							MarkdownDescription: "Hostname of the vPC peer, if the switch is part of a vPC pair",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SwitchesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*NdfcProviderData).Client
+}
+
+func (d *SwitchesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config Switches
+
+	// Read config
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := config.Timeouts.Read(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", config.Id.String()))
+
+	var re *regexp.Regexp
+	if !config.HostnameRegex.IsNull() {
+		var err error
+		re, err = regexp.Compile(config.HostnameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("hostname_regex"), "Invalid Attribute Value", fmt.Sprintf("Invalid regular expression: %s", err))
+			return
+		}
+	}
+
+	switches, err := d.client.GetSwitches(ctx, config.FabricName.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve object", err, nil))
+		return
+	}
+
+	if err := config.fromSwitches(switches, re); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to read switches: %s", err))
+		return
+	}
+	config.Id = types.StringValue(config.FabricName.ValueString())
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", config.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,52 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceNdfcSwitches(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceNdfcSwitchesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ndfc_switches.test", "switches.%", "2"),
+					resource.TestCheckResourceAttr("data.ndfc_switches.test", "switches.LEAF1.serial_number", "9DBYO6WQJ46"),
+					resource.TestCheckResourceAttr("data.ndfc_switches.test", "switches.LEAF1.ip_address", "10.0.0.101"),
+					resource.TestCheckResourceAttr("data.ndfc_switches.test", "switches.LEAF1.role", "leaf"),
+					resource.TestCheckResourceAttr("data.ndfc_switches.test", "switches.LEAF2.serial_number", "9RB5Y9BFNTU"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceNdfcSwitchesConfig = `
+
+data "ndfc_switches" "test" {
+	fabric_name = "CML"
+	hostname_regex = "^LEAF"
+	role = "leaf"
+}
+`
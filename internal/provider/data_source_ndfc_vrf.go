@@ -24,11 +24,13 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/netascode/go-nd"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/helpers"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
 )
 
 //template:end imports
@@ -46,7 +48,7 @@ func NewVRFDataSource() datasource.DataSource {
 }
 
 type VRFDataSource struct {
-	client *nd.Client
+	client *ndfc.Client
 }
 
 func (d *VRFDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -63,6 +65,7 @@ func (d *VRFDataSource) Schema(ctx context.Context, req datasource.SchemaRequest
 				MarkdownDescription: "The id of the object",
 				Computed:            true,
 			},
+			"timeouts": helpers.DataSourceTimeouts(ctx),
 			"fabric_name": schema.StringAttribute{
 				MarkdownDescription: "The name of the fabric",
 				Required:            true,
@@ -224,7 +227,7 @@ func (d *VRFDataSource) Schema(ctx context.Context, req datasource.SchemaRequest
 				Computed:            true,
 			},
 			"attachments": schema.SetNestedAttribute{
-				MarkdownDescription: "A list of attachments",
+				MarkdownDescription: "A list of attachments. Switches attached outside of Terraform show up as a diff, except for those in `unmanaged_attachments`. If not set, the attachments of the VRF are not managed",
 				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -252,9 +255,58 @@ func (d *VRFDataSource) Schema(ctx context.Context, req datasource.SchemaRequest
 							MarkdownDescription: "Override loopback IPv6 address",
 							Computed:            true,
 						},
+						"vrf_lite": schema.ListNestedAttribute{
+							MarkdownDescription: "VRF Lite connections of the attachment on a border switch, serialized into the extension values of the attachment",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"interface_name": schema.StringAttribute{
+										MarkdownDescription: "Interface of the VRF Lite connection",
+										Computed:            true,
+									},
+									"dot1q_id": schema.Int64Attribute{
+										MarkdownDescription: "DOT1Q ID of the VRF Lite subinterface",
+										Computed:            true,
+									},
+									"ipv4_address": schema.StringAttribute{
+										MarkdownDescription: "IPv4 address of the VRF Lite subinterface with mask",
+										Computed:            true,
+									},
+									"neighbor_ipv4": schema.StringAttribute{
+										MarkdownDescription: "IPv4 address of the neighbor",
+										Computed:            true,
+									},
+									"neighbor_asn": schema.StringAttribute{
+										MarkdownDescription: "BGP ASN of the neighbor",
+										Computed:            true,
+									},
+									"ipv6_address": schema.StringAttribute{
+										MarkdownDescription: "IPv6 address of the VRF Lite subinterface with mask",
+										Computed:            true,
+									},
+									"neighbor_ipv6": schema.StringAttribute{
+										MarkdownDescription: "IPv6 address of the neighbor",
+										Computed:            true,
+									},
+									"peer_vrf_name": schema.StringAttribute{
+										MarkdownDescription: "Name of the VRF on the neighbor",
+										Computed:            true,
+									},
+									"auto_vrf_lite": schema.BoolAttribute{
+										MarkdownDescription: "Connection was created by the auto VRF Lite of the fabric",
+										Computed:            true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
+			"attachment_status": schema.MapAttribute{
+				MarkdownDescription: "Deployment state of the VRF on the switches it is attached to, keyed by serial number. A switch with `deploy_config` enabled that is not `DEPLOYED` is deployed again on the next apply. Example: `OUT-OF-SYNC`",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -271,33 +323,45 @@ func (d *VRFDataSource) Configure(_ context.Context, req datasource.ConfigureReq
 
 func (d *VRFDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var config VRF
+	// Attributes of the model that are only used by the resource
+	excluded := map[string]attr.Type{
+		"unmanaged_attachments": types.ListType{ElemType: types.StringType},
+	}
 
 	// Read config
-	diags := req.Config.Get(ctx, &config)
+	diags := helpers.GetDataSourceConfig(ctx, req.Config, &config, excluded)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := config.Timeouts.Read(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", config.Id.String()))
 
-	res, err := d.client.Get(fmt.Sprintf("%v%v", config.getPath(), config.VrfName.ValueString()))
+	res, err := d.client.Get(ctx, fmt.Sprintf("%v%v", config.getPath(), config.VrfName.ValueString()))
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object, got error: %s", err))
+		resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve object", err, nil))
 		return
 	}
 	config.fromBody(ctx, res)
 	config.Id = types.StringValue(config.FabricName.ValueString() + "/" + config.VrfName.ValueString())
 
-	res, err = d.client.Get(fmt.Sprintf("%vattachments?vrf-names=%v", config.getPath(), config.VrfName.ValueString()))
+	res, err = d.client.Get(ctx, fmt.Sprintf("%vattachments?vrf-names=%v", config.getPath(), config.VrfName.ValueString()))
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve VRF attachments, got error: %s, %s", err, res.String()))
+		resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve VRF attachments", err, nil))
 		return
 	}
-	config.fromBodyAttachments(ctx, res, true)
+	config.fromBodyAttachments(ctx, res)
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", config.Id.ValueString()))
 
-	diags = resp.State.Set(ctx, &config)
+	diags = helpers.SetDataSourceState(ctx, &resp.State, &config, excluded)
 	resp.Diagnostics.Append(diags...)
 }
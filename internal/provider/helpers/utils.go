@@ -19,21 +19,29 @@ package helpers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	dstimeouts "github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/netascode/go-nd"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
 	"github.com/tidwall/gjson"
-	"github.com/tidwall/sjson"
 )
 
 const (
-	NDFC_CHECK_STATUS_RETRIES = 3
-	NDFC_CHECK_STATUS_DELAY   = 4
+	// NDFC_DEFAULT_TIMEOUT applies to operations without a configured timeout.
+	NDFC_DEFAULT_TIMEOUT = 20 * time.Minute
 )
 
 func Contains(s []string, str string) bool {
@@ -53,27 +61,175 @@ func GetListString(result []gjson.Result) types.List {
 	return types.ListValueMust(types.StringType, v)
 }
 
-func DeployInterface(ctx context.Context, client *nd.Client, serialNumber, interfaceName string) diag.Diagnostics {
+// GetCommaSeparatedList returns the list of a comma separated NDFC template
+// parameter, e.g. "Ethernet1/1,Ethernet1/2".
+func GetCommaSeparatedList(s string) types.List {
+	parts := strings.Split(s, ",")
+	v := make([]attr.Value, len(parts))
+	for i := range parts {
+		v[i] = types.StringValue(strings.TrimSpace(parts[i]))
+	}
+	return types.ListValueMust(types.StringType, v)
+}
+
+// ClientError returns the diagnostic for a failed NDFC request. When NDFC
+// names the field it rejected and fields maps it to an attribute, the
+// diagnostic is attached to that attribute.
+func ClientError(detail string, err error, fields map[string]path.Path) diag.Diagnostic {
+	msg := fmt.Sprintf("%s, got error: %s", detail, err)
+	var e *ndfc.Error
+	if errors.As(err, &e) && e.Field != "" {
+		if p, ok := fields[e.Field]; ok {
+			return diag.NewAttributeErrorDiagnostic(p, "Client Error", msg)
+		}
+	}
+	return diag.NewErrorDiagnostic("Client Error", msg)
+}
+
+// DataSourceTimeouts returns the "timeouts" attribute of data sources. Data
+// sources share their model with the resource, so the attribute is decoded
+// into the resource timeouts type, which only carries a read timeout here.
+func DataSourceTimeouts(ctx context.Context) schema.Attribute {
+	a := dstimeouts.Attributes(ctx).(schema.SingleNestedAttribute)
+	a.CustomType = timeouts.Type{
+		ObjectType: types.ObjectType{
+			AttrTypes: map[string]attr.Type{"read": types.StringType},
+		},
+	}
+	return a
+}
+
+// GetDataSourceConfig reads the config of a data source into target, the
+// model shared with the resource. excluded are the attributes of the model
+// that are not part of the data source schema, they are left null.
+func GetDataSourceConfig(ctx context.Context, config tfsdk.Config, target interface{}, excluded map[string]attr.Type) diag.Diagnostics {
+	var obj types.Object
+	diags := config.Get(ctx, &obj)
+	if diags.HasError() {
+		return diags
+	}
+	attrTypes := obj.AttributeTypes(ctx)
+	attrs := obj.Attributes()
+	for name, t := range excluded {
+		v, err := t.ValueFromTerraform(ctx, tftypes.NewValue(t.TerraformType(ctx), nil))
+		if err != nil {
+			diags.AddError("Value Conversion Error", fmt.Sprintf("Failed to create null value of %s: %s", name, err))
+			return diags
+		}
+		attrTypes[name] = t
+		attrs[name] = v
+	}
+	full, d := types.ObjectValue(attrTypes, attrs)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(full.As(ctx, target, basetypes.ObjectAsOptions{})...)
+	return diags
+}
+
+// SetDataSourceState sets the state of a data source to source, the model
+// shared with the resource, leaving out the excluded attributes.
+func SetDataSourceState(ctx context.Context, state *tfsdk.State, source interface{}, excluded map[string]attr.Type) diag.Diagnostics {
+	var diags diag.Diagnostics
+	objType, ok := state.Schema.Type().(types.ObjectType)
+	if !ok {
+		diags.AddError("Value Conversion Error", "Data source schema is not an object")
+		return diags
+	}
+	attrTypes := map[string]attr.Type{}
+	for name, t := range objType.AttrTypes {
+		attrTypes[name] = t
+	}
+	for name, t := range excluded {
+		attrTypes[name] = t
+	}
+	full, d := types.ObjectValueFrom(ctx, attrTypes, source)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	attrs := full.Attributes()
+	for name := range excluded {
+		delete(attrs, name)
+	}
+	obj, d := types.ObjectValue(objType.AttrTypes, attrs)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(state.Set(ctx, obj)...)
+	return diags
+}
+
+// WaitError returns the diagnostic for a wait that did not complete, either
+// because the operation timed out or because it was cancelled.
+func WaitError(detail string, err error) diag.Diagnostic {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("%s, timed out. The timeout can be raised with the timeouts attribute.", detail))
+	}
+	return ClientError(detail, err, nil)
+}
+
+func DeployInterface(ctx context.Context, client *ndfc.Client, serialNumber, interfaceName string) diag.Diagnostics {
 	var diags diag.Diagnostics
 	id := serialNumber + "/" + interfaceName
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Deploy Interface", id))
 
-	body := ""
-	body, _ = sjson.Set(body, "0.serialNumber", serialNumber)
-	body, _ = sjson.Set(body, "0.ifName", interfaceName)
-	res, err := client.Post("/lan-fabric/rest/interface/deploy", body)
+	_, err := client.DeployInterfaces(ctx, ndfc.InterfaceRef{SerialNumber: serialNumber, IfName: interfaceName})
+	if err != nil {
+		diags.Append(ClientError(fmt.Sprintf("Failed to deploy interface (%s, %s)", serialNumber, interfaceName), err, nil))
+		return diags
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Deploy Interface finished successfully", id))
+
+	return diags
+}
+
+// DeployInterfaces deploys the given interfaces with a single request.
+func DeployInterfaces(ctx context.Context, client *ndfc.Client, refs ...ndfc.InterfaceRef) diag.Diagnostics {
+	var diags diag.Diagnostics
+	names := make([]string, len(refs))
+	for i, ref := range refs {
+		names[i] = ref.SerialNumber + "/" + ref.IfName
+	}
+	id := strings.Join(names, ",")
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Deploy Interfaces", id))
+
+	_, err := client.DeployInterfaces(ctx, refs...)
 	if err != nil {
-		diags.AddError("Client Error", fmt.Sprintf("Failed to deploy interface, got error: %s, %s", err, res.String()))
+		diags.Append(ClientError(fmt.Sprintf("Failed to deploy interfaces (%s)", strings.Join(names, ", ")), err, nil))
 		return diags
 	}
 
-	t := res.Get("0.reportItemType").String()
-	if t == "ERROR" {
-		diags.AddError("Client Error", fmt.Sprintf("Failed to deploy interface (%s, %s), got error: %s", serialNumber, interfaceName, res.Get("0.message").String()))
+	tflog.Debug(ctx, fmt.Sprintf("%s: Deploy Interfaces finished successfully", id))
+
+	return diags
+}
+
+// DeploySwitches deploys the pending configuration of the switches with the
+// given serial numbers. The fabric is looked up from the first switch.
+func DeploySwitches(ctx context.Context, client *ndfc.Client, serialNumbers ...string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if len(serialNumbers) == 0 {
 		return diags
 	}
+	id := strings.Join(serialNumbers, "/")
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Deploy Switches", id))
 
-	tflog.Debug(ctx, fmt.Sprintf("%s: Deploy Interface finished successfully", id))
+	fabric, err := client.GetSwitchFabric(ctx, serialNumbers[0])
+	if err != nil {
+		diags.Append(ClientError(fmt.Sprintf("Failed to look up fabric of switch %s", serialNumbers[0]), err, nil))
+		return diags
+	}
+	err = client.DeploySwitches(ctx, fabric, serialNumbers...)
+	if err != nil {
+		diags.Append(ClientError(fmt.Sprintf("Failed to deploy switches (%s)", strings.Join(serialNumbers, ", ")), err, nil))
+		return diags
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Deploy Switches finished successfully", id))
 
 	return diags
 }
@@ -88,3 +244,69 @@ func CheckAttachmentResponse(ctx context.Context, response gjson.Result) diag.Di
 	})
 	return diags
 }
+
+// AttachmentStatus returns the lanAttachState of the switches an object is
+// attached to, or still deployed on, keyed by serial number. entry is the
+// element of an attachments response holding the lanAttachList.
+func AttachmentStatus(entry gjson.Result) types.Map {
+	status := map[string]attr.Value{}
+	entry.Get("lanAttachList").ForEach(func(_, v gjson.Result) bool {
+		state := v.Get("lanAttachState").String()
+		if v.Get("isLanAttached").Bool() || (state != "" && state != ndfc.StateNA) {
+			status[v.Get("switchSerialNo").String()] = types.StringValue(state)
+		}
+		return true
+	})
+	return types.MapValueMust(types.StringType, status)
+}
+
+// FilterAttachmentStatus returns the entries of status for the switches with
+// the given serial numbers, or status itself if serialNumbers is nil.
+func FilterAttachmentStatus(status types.Map, serialNumbers map[string]bool) types.Map {
+	if serialNumbers == nil || status.IsNull() || status.IsUnknown() {
+		return status
+	}
+	elems := map[string]attr.Value{}
+	for serial, v := range status.Elements() {
+		if serialNumbers[serial] {
+			elems[serial] = v
+		}
+	}
+	return types.MapValueMust(types.StringType, elems)
+}
+
+// PlannedAttachmentStatus returns the attachment status expected after an
+// apply for the switches with the given serial numbers. status returns the
+// expected state of a switch, or false if it is only known after the apply.
+func PlannedAttachmentStatus(serialNumbers []types.String, status func(serialNumber string) (string, bool)) types.Map {
+	elems := make(map[string]attr.Value, len(serialNumbers))
+	for _, serial := range serialNumbers {
+		if serial.IsUnknown() {
+			return types.MapUnknown(types.StringType)
+		}
+		if state, ok := status(serial.ValueString()); ok {
+			elems[serial.ValueString()] = types.StringValue(state)
+		} else {
+			elems[serial.ValueString()] = types.StringUnknown()
+		}
+	}
+	return types.MapValueMust(types.StringType, elems)
+}
+
+// DeployAttachment deploys the attachment of the named object to the switch
+// with the given serial number and waits for it to complete.
+func DeployAttachment(ctx context.Context, client *ndfc.Client, t ndfc.ObjectType, fabric, name, serialNumber string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	id := fabric + "/" + name + "/" + serialNumber
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Deploy", id))
+
+	err := client.Deploy(ctx, t, fabric, name, serialNumber)
+	if err != nil {
+		diags.Append(WaitError(fmt.Sprintf("Failed to deploy %s %s on switch %s", t.Name, name, serialNumber), err))
+		return diags
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Deploy finished successfully", id))
+
+	return diags
+}
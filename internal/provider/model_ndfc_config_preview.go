@@ -0,0 +1,45 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ConfigPreview struct {
+	Id            types.String   `tfsdk:"id"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+	SerialNumber  types.String   `tfsdk:"serial_number"`
+	VrfName       types.String   `tfsdk:"vrf_name"`
+	NetworkName   types.String   `tfsdk:"network_name"`
+	InterfaceName types.String   `tfsdk:"interface_name"`
+	Config        types.String   `tfsdk:"config"`
+}
+
+// getId returns the id of the preview: the serial number, followed by the
+// VRF, network or interface name the preview is limited to, if any.
+func (data ConfigPreview) getId() string {
+	id := data.SerialNumber.ValueString()
+	for _, scope := range []types.String{data.VrfName, data.NetworkName, data.InterfaceName} {
+		if !scope.IsNull() {
+			id += ":" + scope.ValueString()
+		}
+	}
+	return id
+}
@@ -0,0 +1,65 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type Deploy struct {
+	Id            types.String   `tfsdk:"id"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+	SerialNumbers types.Set      `tfsdk:"serial_numbers"`
+	Interfaces    types.Set      `tfsdk:"interfaces"`
+	Triggers      types.Map      `tfsdk:"triggers"`
+	ConfigStatus  types.Map      `tfsdk:"config_status"`
+}
+
+// serialNumbers returns the sorted serial numbers of the switches to deploy.
+func (data Deploy) serialNumbers(ctx context.Context) []string {
+	var serials []string
+	if !data.SerialNumbers.IsNull() && !data.SerialNumbers.IsUnknown() {
+		data.SerialNumbers.ElementsAs(ctx, &serials, false)
+	}
+	sort.Strings(serials)
+	return serials
+}
+
+// interfaces returns the sorted keys of the interfaces to deploy, in the
+// format "<serial_number>:<interface_name>".
+func (data Deploy) interfaces(ctx context.Context) []string {
+	var keys []string
+	if !data.Interfaces.IsNull() && !data.Interfaces.IsUnknown() {
+		data.Interfaces.ElementsAs(ctx, &keys, false)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (data *Deploy) fromConfigStatus(status map[string]string) {
+	v := make(map[string]attr.Value, len(status))
+	for k, s := range status {
+		v[k] = types.StringValue(s)
+	}
+	data.ConfigStatus = types.MapValueMust(types.StringType, v)
+}
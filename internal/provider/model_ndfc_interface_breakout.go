@@ -0,0 +1,51 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
+)
+
+type InterfaceBreakout struct {
+	Id            types.String   `tfsdk:"id"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+	SerialNumber  types.String   `tfsdk:"serial_number"`
+	InterfaceName types.String   `tfsdk:"interface_name"`
+	BreakoutMap   types.String   `tfsdk:"breakout_map"`
+	Interfaces    types.List     `tfsdk:"interfaces"`
+}
+
+func (data InterfaceBreakout) ref() ndfc.InterfaceRef {
+	return ndfc.InterfaceRef{SerialNumber: data.SerialNumber.ValueString(), IfName: data.InterfaceName.ValueString()}
+}
+
+// children returns the names of the child interfaces of the breakout.
+func (data InterfaceBreakout) children() ([]string, error) {
+	return ndfc.BreakoutChildren(data.InterfaceName.ValueString(), data.BreakoutMap.ValueString())
+}
+
+func (data *InterfaceBreakout) fromChildren(children []string) {
+	v := make([]attr.Value, len(children))
+	for i := range children {
+		v[i] = types.StringValue(children[i])
+	}
+	data.Interfaces = types.ListValueMust(types.StringType, v)
+}
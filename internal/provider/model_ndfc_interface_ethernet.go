@@ -23,7 +23,10 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/tidwall/gjson"
 	"github.com/tidwall/sjson"
@@ -33,25 +36,28 @@ import (
 
 //template:begin types
 type InterfaceEthernet struct {
-	Id                   types.String `tfsdk:"id"`
-	SerialNumber         types.String `tfsdk:"serial_number"`
-	InterfaceName        types.String `tfsdk:"interface_name"`
-	Policy               types.String `tfsdk:"policy"`
-	BpduGuard            types.String `tfsdk:"bpdu_guard"`
-	PortTypeFast         types.Bool   `tfsdk:"port_type_fast"`
-	Mtu                  types.String `tfsdk:"mtu"`
-	Speed                types.String `tfsdk:"speed"`
-	AccessVlan           types.Int64  `tfsdk:"access_vlan"`
-	InterfaceDescription types.String `tfsdk:"interface_description"`
-	OrphanPort           types.Bool   `tfsdk:"orphan_port"`
-	FreeformConfig       types.String `tfsdk:"freeform_config"`
-	AdminState           types.Bool   `tfsdk:"admin_state"`
-	Ptp                  types.Bool   `tfsdk:"ptp"`
-	Netflow              types.Bool   `tfsdk:"netflow"`
-	NetflowMonitor       types.String `tfsdk:"netflow_monitor"`
-	NetflowSampler       types.String `tfsdk:"netflow_sampler"`
-	AllowedVlans         types.String `tfsdk:"allowed_vlans"`
-	NativeVlan           types.Int64  `tfsdk:"native_vlan"`
+	Id                   types.String   `tfsdk:"id"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+	SerialNumber         types.String   `tfsdk:"serial_number"`
+	InterfaceName        types.String   `tfsdk:"interface_name"`
+	Policy               types.String   `tfsdk:"policy"`
+	DestroyPolicy        types.String   `tfsdk:"destroy_policy"`
+	Deploy               types.Bool     `tfsdk:"deploy"`
+	BpduGuard            types.String   `tfsdk:"bpdu_guard"`
+	PortTypeFast         types.Bool     `tfsdk:"port_type_fast"`
+	Mtu                  types.String   `tfsdk:"mtu"`
+	Speed                types.String   `tfsdk:"speed"`
+	AccessVlan           types.Int64    `tfsdk:"access_vlan"`
+	InterfaceDescription types.String   `tfsdk:"interface_description"`
+	OrphanPort           types.Bool     `tfsdk:"orphan_port"`
+	FreeformConfig       types.String   `tfsdk:"freeform_config"`
+	AdminState           types.Bool     `tfsdk:"admin_state"`
+	Ptp                  types.Bool     `tfsdk:"ptp"`
+	Netflow              types.Bool     `tfsdk:"netflow"`
+	NetflowMonitor       types.String   `tfsdk:"netflow_monitor"`
+	NetflowSampler       types.String   `tfsdk:"netflow_sampler"`
+	AllowedVlans         types.String   `tfsdk:"allowed_vlans"`
+	NativeVlan           types.Int64    `tfsdk:"native_vlan"`
 }
 
 //template:end types
@@ -63,6 +69,32 @@ func (data InterfaceEthernet) getPath() string {
 
 //template:end getPath
 
+//template:begin fieldPaths
+func (data InterfaceEthernet) fieldPaths() map[string]path.Path {
+	return map[string]path.Path{
+		"serialNumber":          path.Root("serial_number"),
+		"ifName":                path.Root("interface_name"),
+		"policy":                path.Root("policy"),
+		"BPDUGUARD_ENABLED":     path.Root("bpdu_guard"),
+		"PORTTYPE_FAST_ENABLED": path.Root("port_type_fast"),
+		"MTU":                   path.Root("mtu"),
+		"SPEED":                 path.Root("speed"),
+		"ACCESS_VLAN":           path.Root("access_vlan"),
+		"DESC":                  path.Root("interface_description"),
+		"ENABLE_ORPHAN_PORT":    path.Root("orphan_port"),
+		"CONF":                  path.Root("freeform_config"),
+		"ADMIN_STATE":           path.Root("admin_state"),
+		"PTP":                   path.Root("ptp"),
+		"ENABLE_NETFLOW":        path.Root("netflow"),
+		"NETFLOW_MONITOR":       path.Root("netflow_monitor"),
+		"NETFLOW_SAMPLER":       path.Root("netflow_sampler"),
+		"ALLOWED_VLANS":         path.Root("allowed_vlans"),
+		"NATIVE_VLAN":           path.Root("native_vlan"),
+	}
+}
+
+//template:end fieldPaths
+
 func (data InterfaceEthernet) toBody(ctx context.Context) string {
 	body := ""
 	if !data.SerialNumber.IsNull() && !data.SerialNumber.IsUnknown() {
@@ -119,6 +151,45 @@ func (data InterfaceEthernet) toBody(ctx context.Context) string {
 	return body
 }
 
+// getDestroyPolicy returns the policy the interface is reset to on destroy.
+func (data InterfaceEthernet) getDestroyPolicy() string {
+	if data.DestroyPolicy.IsNull() || data.DestroyPolicy.IsUnknown() {
+		return "int_trunk_host"
+	}
+	return data.DestroyPolicy.ValueString()
+}
+
+// toDestroyBody returns the body resetting the interface to destroy_policy.
+// Only the interface name is set, see withNvPairDefaults for the values of
+// the other policy parameters.
+func (data InterfaceEthernet) toDestroyBody(ctx context.Context) string {
+	body, _ := sjson.Set("", "policy", data.getDestroyPolicy())
+	body, _ = sjson.Set(body, "interfaces.0.serialNumber", data.SerialNumber.ValueString())
+	body, _ = sjson.Set(body, "interfaces.0.ifName", data.InterfaceName.ValueString())
+	body, _ = sjson.Set(body, "interfaces.0.nvPairs.INTF_NAME", data.InterfaceName.ValueString())
+	return body
+}
+
+// withNvPairDefaults sets the nvPairs of all interfaces of body that are not
+// set yet to defaults, the default values of the policy parameters. NDFC
+// keeps the previous values of parameters missing from a request.
+func withNvPairDefaults(body string, defaults map[string]string) string {
+	names := make([]string, 0, len(defaults))
+	for name := range defaults {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for i := range gjson.Get(body, "interfaces").Array() {
+		for _, name := range names {
+			path := fmt.Sprintf("interfaces.%d.nvPairs.%s", i, name)
+			if !gjson.Get(body, path).Exists() {
+				body, _ = sjson.Set(body, path, defaults[name])
+			}
+		}
+	}
+	return body
+}
+
 func (data *InterfaceEthernet) fromBody(ctx context.Context, res gjson.Result) {
 	if value := res.Get("0.interfaces.0.serialNumber"); value.Exists() && value.String() != "" {
 		data.SerialNumber = types.StringValue(value.String())
@@ -24,6 +24,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/tidwall/gjson"
 	"github.com/tidwall/sjson"
@@ -33,17 +35,19 @@ import (
 
 //template:begin types
 type InterfaceLoopback struct {
-	Id                   types.String `tfsdk:"id"`
-	SerialNumber         types.String `tfsdk:"serial_number"`
-	InterfaceName        types.String `tfsdk:"interface_name"`
-	Policy               types.String `tfsdk:"policy"`
-	Vrf                  types.String `tfsdk:"vrf"`
-	Ipv4Address          types.String `tfsdk:"ipv4_address"`
-	Ipv6Address          types.String `tfsdk:"ipv6_address"`
-	RouteMapTag          types.String `tfsdk:"route_map_tag"`
-	InterfaceDescription types.String `tfsdk:"interface_description"`
-	FreeformConfig       types.String `tfsdk:"freeform_config"`
-	AdminState           types.Bool   `tfsdk:"admin_state"`
+	Id                   types.String   `tfsdk:"id"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+	SerialNumber         types.String   `tfsdk:"serial_number"`
+	InterfaceName        types.String   `tfsdk:"interface_name"`
+	Policy               types.String   `tfsdk:"policy"`
+	Deploy               types.Bool     `tfsdk:"deploy"`
+	Vrf                  types.String   `tfsdk:"vrf"`
+	Ipv4Address          types.String   `tfsdk:"ipv4_address"`
+	Ipv6Address          types.String   `tfsdk:"ipv6_address"`
+	RouteMapTag          types.String   `tfsdk:"route_map_tag"`
+	InterfaceDescription types.String   `tfsdk:"interface_description"`
+	FreeformConfig       types.String   `tfsdk:"freeform_config"`
+	AdminState           types.Bool     `tfsdk:"admin_state"`
 }
 
 //template:end types
@@ -55,6 +59,24 @@ func (data InterfaceLoopback) getPath() string {
 
 //template:end getPath
 
+//template:begin fieldPaths
+func (data InterfaceLoopback) fieldPaths() map[string]path.Path {
+	return map[string]path.Path{
+		"serialNumber":  path.Root("serial_number"),
+		"ifName":        path.Root("interface_name"),
+		"policy":        path.Root("policy"),
+		"INTF_VRF":      path.Root("vrf"),
+		"IP":            path.Root("ipv4_address"),
+		"V6IP":          path.Root("ipv6_address"),
+		"ROUTE_MAP_TAG": path.Root("route_map_tag"),
+		"DESC":          path.Root("interface_description"),
+		"CONF":          path.Root("freeform_config"),
+		"ADMIN_STATE":   path.Root("admin_state"),
+	}
+}
+
+//template:end fieldPaths
+
 func (data InterfaceLoopback) toBody(ctx context.Context) string {
 	body := ""
 	if !data.SerialNumber.IsNull() && !data.SerialNumber.IsUnknown() {
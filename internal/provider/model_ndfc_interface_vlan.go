@@ -24,6 +24,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/tidwall/gjson"
 	"github.com/tidwall/sjson"
@@ -33,36 +35,38 @@ import (
 
 //template:begin types
 type InterfaceVlan struct {
-	Id                        types.String `tfsdk:"id"`
-	SerialNumber              types.String `tfsdk:"serial_number"`
-	InterfaceName             types.String `tfsdk:"interface_name"`
-	Policy                    types.String `tfsdk:"policy"`
-	Vrf                       types.String `tfsdk:"vrf"`
-	Ipv4Address               types.String `tfsdk:"ipv4_address"`
-	Ipv4PrefixLength          types.Int64  `tfsdk:"ipv4_prefix_length"`
-	Mtu                       types.Int64  `tfsdk:"mtu"`
-	RoutingTag                types.String `tfsdk:"routing_tag"`
-	DisableIpRedirects        types.Bool   `tfsdk:"disable_ip_redirects"`
-	InterfaceDescription      types.String `tfsdk:"interface_description"`
-	FreeformConfig            types.String `tfsdk:"freeform_config"`
-	AdminState                types.Bool   `tfsdk:"admin_state"`
-	Hsrp                      types.Bool   `tfsdk:"hsrp"`
-	HsrpVip                   types.String `tfsdk:"hsrp_vip"`
-	HsrpGroup                 types.Int64  `tfsdk:"hsrp_group"`
-	HsrpVersion               types.String `tfsdk:"hsrp_version"`
-	HsrpPriority              types.Int64  `tfsdk:"hsrp_priority"`
-	HsrpPreempt               types.Bool   `tfsdk:"hsrp_preempt"`
-	HsrpMac                   types.String `tfsdk:"hsrp_mac"`
-	DhcpServer1               types.String `tfsdk:"dhcp_server_1"`
-	DhcpServer1Vrf            types.String `tfsdk:"dhcp_server_1_vrf"`
-	DhcpServer2               types.String `tfsdk:"dhcp_server_2"`
-	DhcpServer2Vrf            types.String `tfsdk:"dhcp_server_2_vrf"`
-	DhcpServer3               types.String `tfsdk:"dhcp_server_3"`
-	DhcpServer3Vrf            types.String `tfsdk:"dhcp_server_3_vrf"`
-	AdvertiseSubnetInUnderlay types.Bool   `tfsdk:"advertise_subnet_in_underlay"`
-	Netflow                   types.Bool   `tfsdk:"netflow"`
-	NetflowMonitor            types.String `tfsdk:"netflow_monitor"`
-	NetflowSampler            types.String `tfsdk:"netflow_sampler"`
+	Id                        types.String   `tfsdk:"id"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
+	SerialNumber              types.String   `tfsdk:"serial_number"`
+	InterfaceName             types.String   `tfsdk:"interface_name"`
+	Policy                    types.String   `tfsdk:"policy"`
+	Deploy                    types.Bool     `tfsdk:"deploy"`
+	Vrf                       types.String   `tfsdk:"vrf"`
+	Ipv4Address               types.String   `tfsdk:"ipv4_address"`
+	Ipv4PrefixLength          types.Int64    `tfsdk:"ipv4_prefix_length"`
+	Mtu                       types.Int64    `tfsdk:"mtu"`
+	RoutingTag                types.String   `tfsdk:"routing_tag"`
+	DisableIpRedirects        types.Bool     `tfsdk:"disable_ip_redirects"`
+	InterfaceDescription      types.String   `tfsdk:"interface_description"`
+	FreeformConfig            types.String   `tfsdk:"freeform_config"`
+	AdminState                types.Bool     `tfsdk:"admin_state"`
+	Hsrp                      types.Bool     `tfsdk:"hsrp"`
+	HsrpVip                   types.String   `tfsdk:"hsrp_vip"`
+	HsrpGroup                 types.Int64    `tfsdk:"hsrp_group"`
+	HsrpVersion               types.String   `tfsdk:"hsrp_version"`
+	HsrpPriority              types.Int64    `tfsdk:"hsrp_priority"`
+	HsrpPreempt               types.Bool     `tfsdk:"hsrp_preempt"`
+	HsrpMac                   types.String   `tfsdk:"hsrp_mac"`
+	DhcpServer1               types.String   `tfsdk:"dhcp_server_1"`
+	DhcpServer1Vrf            types.String   `tfsdk:"dhcp_server_1_vrf"`
+	DhcpServer2               types.String   `tfsdk:"dhcp_server_2"`
+	DhcpServer2Vrf            types.String   `tfsdk:"dhcp_server_2_vrf"`
+	DhcpServer3               types.String   `tfsdk:"dhcp_server_3"`
+	DhcpServer3Vrf            types.String   `tfsdk:"dhcp_server_3_vrf"`
+	AdvertiseSubnetInUnderlay types.Bool     `tfsdk:"advertise_subnet_in_underlay"`
+	Netflow                   types.Bool     `tfsdk:"netflow"`
+	NetflowMonitor            types.String   `tfsdk:"netflow_monitor"`
+	NetflowSampler            types.String   `tfsdk:"netflow_sampler"`
 }
 
 //template:end types
@@ -74,6 +78,43 @@ func (data InterfaceVlan) getPath() string {
 
 //template:end getPath
 
+//template:begin fieldPaths
+func (data InterfaceVlan) fieldPaths() map[string]path.Path {
+	return map[string]path.Path{
+		"serialNumber":         path.Root("serial_number"),
+		"ifName":               path.Root("interface_name"),
+		"policy":               path.Root("policy"),
+		"INTF_VRF":             path.Root("vrf"),
+		"IP":                   path.Root("ipv4_address"),
+		"PREFIX":               path.Root("ipv4_prefix_length"),
+		"MTU":                  path.Root("mtu"),
+		"ROUTING_TAG":          path.Root("routing_tag"),
+		"DISABLE_IP_REDIRECTS": path.Root("disable_ip_redirects"),
+		"DESC":                 path.Root("interface_description"),
+		"CONF":                 path.Root("freeform_config"),
+		"ADMIN_STATE":          path.Root("admin_state"),
+		"ENABLE_HSRP":          path.Root("hsrp"),
+		"HSRP_VIP":             path.Root("hsrp_vip"),
+		"HSRP_GROUP":           path.Root("hsrp_group"),
+		"HSRP_VERSION":         path.Root("hsrp_version"),
+		"HSRP_PRIORITY":        path.Root("hsrp_priority"),
+		"PREEMPT":              path.Root("hsrp_preempt"),
+		"MAC":                  path.Root("hsrp_mac"),
+		"dhcpServerAddr1":      path.Root("dhcp_server_1"),
+		"vrfDhcp1":             path.Root("dhcp_server_1_vrf"),
+		"dhcpServerAddr2":      path.Root("dhcp_server_2"),
+		"vrfDhcp2":             path.Root("dhcp_server_2_vrf"),
+		"dhcpServerAddr3":      path.Root("dhcp_server_3"),
+		"vrfDhcp3":             path.Root("dhcp_server_3_vrf"),
+		"advSubnetInUnderlay":  path.Root("advertise_subnet_in_underlay"),
+		"ENABLE_NETFLOW":       path.Root("netflow"),
+		"NETFLOW_MONITOR":      path.Root("netflow_monitor"),
+		"NETFLOW_SAMPLER":      path.Root("netflow_sampler"),
+	}
+}
+
+//template:end fieldPaths
+
 func (data InterfaceVlan) toBody(ctx context.Context) string {
 	body := ""
 	if !data.SerialNumber.IsNull() && !data.SerialNumber.IsUnknown() {
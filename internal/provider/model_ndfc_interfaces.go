@@ -0,0 +1,299 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+type Interfaces struct {
+	Id            types.String                    `tfsdk:"id"`
+	Timeouts      timeouts.Value                  `tfsdk:"timeouts"`
+	DestroyPolicy types.String                    `tfsdk:"destroy_policy"`
+	Deploy        types.Bool                      `tfsdk:"deploy"`
+	Interfaces    map[string]InterfacesInterfaces `tfsdk:"interfaces"`
+}
+
+type InterfacesInterfaces struct {
+	Policy               types.String `tfsdk:"policy"`
+	BpduGuard            types.String `tfsdk:"bpdu_guard"`
+	PortTypeFast         types.Bool   `tfsdk:"port_type_fast"`
+	Mtu                  types.String `tfsdk:"mtu"`
+	Speed                types.String `tfsdk:"speed"`
+	AccessVlan           types.Int64  `tfsdk:"access_vlan"`
+	InterfaceDescription types.String `tfsdk:"interface_description"`
+	OrphanPort           types.Bool   `tfsdk:"orphan_port"`
+	FreeformConfig       types.String `tfsdk:"freeform_config"`
+	AdminState           types.Bool   `tfsdk:"admin_state"`
+	Ptp                  types.Bool   `tfsdk:"ptp"`
+	Netflow              types.Bool   `tfsdk:"netflow"`
+	NetflowMonitor       types.String `tfsdk:"netflow_monitor"`
+	NetflowSampler       types.String `tfsdk:"netflow_sampler"`
+	AllowedVlans         types.String `tfsdk:"allowed_vlans"`
+	NativeVlan           types.Int64  `tfsdk:"native_vlan"`
+}
+
+// interfacesKeys returns the sorted keys of interfaces.
+func interfacesKeys(interfaces map[string]InterfacesInterfaces) []string {
+	keys := make([]string, 0, len(interfaces))
+	for key := range interfaces {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// interfacesRef returns the interface of a key in the format
+// "<serial_number>:<interface_name>".
+func interfacesRef(key string) ndfc.InterfaceRef {
+	serialNumber, ifName, _ := strings.Cut(key, ":")
+	return ndfc.InterfaceRef{SerialNumber: serialNumber, IfName: ifName}
+}
+
+func interfacesRefs(keys []string) []ndfc.InterfaceRef {
+	refs := make([]ndfc.InterfaceRef, len(keys))
+	for i, key := range keys {
+		refs[i] = interfacesRef(key)
+	}
+	return refs
+}
+
+// serialNumbers returns the sorted serial numbers of the switches the
+// interfaces belong to.
+func (data Interfaces) serialNumbers() []string {
+	var serials []string
+	for _, key := range interfacesKeys(data.Interfaces) {
+		serial := interfacesRef(key).SerialNumber
+		if len(serials) == 0 || serials[len(serials)-1] != serial {
+			serials = append(serials, serial)
+		}
+	}
+	sort.Strings(serials)
+	return serials
+}
+
+// ethernet returns the interface at key as an ndfc_interface_ethernet
+// object, which is used to build and parse the NDFC payloads.
+func (data Interfaces) ethernet(key string) InterfaceEthernet {
+	ref := interfacesRef(key)
+	item := data.Interfaces[key]
+	return InterfaceEthernet{
+		SerialNumber:         types.StringValue(ref.SerialNumber),
+		InterfaceName:        types.StringValue(ref.IfName),
+		Policy:               item.Policy,
+		DestroyPolicy:        data.DestroyPolicy,
+		BpduGuard:            item.BpduGuard,
+		PortTypeFast:         item.PortTypeFast,
+		Mtu:                  item.Mtu,
+		Speed:                item.Speed,
+		AccessVlan:           item.AccessVlan,
+		InterfaceDescription: item.InterfaceDescription,
+		OrphanPort:           item.OrphanPort,
+		FreeformConfig:       item.FreeformConfig,
+		AdminState:           item.AdminState,
+		Ptp:                  item.Ptp,
+		Netflow:              item.Netflow,
+		NetflowMonitor:       item.NetflowMonitor,
+		NetflowSampler:       item.NetflowSampler,
+		AllowedVlans:         item.AllowedVlans,
+		NativeVlan:           item.NativeVlan,
+	}
+}
+
+// changed returns the sorted keys of the interfaces in data whose payload
+// differs from the one in state, including interfaces missing in state.
+func (data Interfaces) changed(ctx context.Context, state Interfaces) []string {
+	var keys []string
+	for _, key := range interfacesKeys(data.Interfaces) {
+		if _, ok := state.Interfaces[key]; !ok || data.ethernet(key).toBody(ctx) != state.ethernet(key).toBody(ctx) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// removed returns the sorted keys of the interfaces in state that are no
+// longer part of data.
+func (data Interfaces) removed(state Interfaces) []string {
+	var keys []string
+	for _, key := range interfacesKeys(state.Interfaces) {
+		if _, ok := data.Interfaces[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// toBodies returns the payloads configuring the interfaces at keys. NDFC
+// takes a single policy per request, so one payload is returned per policy,
+// each holding all interfaces using that policy.
+func (data Interfaces) toBodies(ctx context.Context, keys []string) []string {
+	return groupInterfaces(keys, func(key string) string {
+		return data.ethernet(key).toBody(ctx)
+	})
+}
+
+// getDestroyPolicy returns the policy removed interfaces are reset to.
+func (data Interfaces) getDestroyPolicy() string {
+	return InterfaceEthernet{DestroyPolicy: data.DestroyPolicy}.getDestroyPolicy()
+}
+
+// toDestroyBodies returns the payloads resetting the interfaces at keys to
+// destroy_policy with defaults, the default values of the policy parameters.
+func (data Interfaces) toDestroyBodies(ctx context.Context, keys []string, defaults map[string]string) []string {
+	return groupInterfaces(keys, func(key string) string {
+		return withNvPairDefaults(data.ethernet(key).toDestroyBody(ctx), defaults)
+	})
+}
+
+// groupInterfaces merges the single interface payloads returned by body into
+// one payload per policy.
+func groupInterfaces(keys []string, body func(key string) string) []string {
+	var policies []string
+	bodies := map[string]string{}
+	for _, key := range keys {
+		b := body(key)
+		policy := gjson.Get(b, "policy").String()
+		if _, ok := bodies[policy]; !ok {
+			policies = append(policies, policy)
+			bodies[policy], _ = sjson.Set("", "policy", policy)
+		}
+		bodies[policy], _ = sjson.SetRaw(bodies[policy], "interfaces.-1", gjson.Get(b, "interfaces.0").Raw)
+	}
+	res := make([]string, len(policies))
+	for i, policy := range policies {
+		res[i] = bodies[policy]
+	}
+	return res
+}
+
+// findInterface returns the policy and interface object of ifName from the
+// interfaces of a switch, in the format returned for a single interface.
+func findInterface(res gjson.Result, ifName string) (gjson.Result, bool) {
+	for _, item := range res.Array() {
+		for _, entry := range item.Get("interfaces").Array() {
+			if strings.EqualFold(entry.Get("ifName").String(), ifName) {
+				body, _ := sjson.Set("[]", "0.policy", item.Get("policy").String())
+				body, _ = sjson.SetRaw(body, "0.interfaces.0", entry.Raw)
+				return gjson.Parse(body), true
+			}
+		}
+	}
+	return gjson.Result{}, false
+}
+
+// fromBody updates the interface at key from res, the policy and interface
+// object returned by NDFC.
+func (data *Interfaces) fromBody(ctx context.Context, key string, res gjson.Result) {
+	var eth InterfaceEthernet
+	eth.fromBody(ctx, res)
+	data.Interfaces[key] = InterfacesInterfaces{
+		Policy:               eth.Policy,
+		BpduGuard:            eth.BpduGuard,
+		PortTypeFast:         eth.PortTypeFast,
+		Mtu:                  eth.Mtu,
+		Speed:                eth.Speed,
+		AccessVlan:           eth.AccessVlan,
+		InterfaceDescription: eth.InterfaceDescription,
+		OrphanPort:           eth.OrphanPort,
+		FreeformConfig:       eth.FreeformConfig,
+		AdminState:           eth.AdminState,
+		Ptp:                  eth.Ptp,
+		Netflow:              eth.Netflow,
+		NetflowMonitor:       eth.NetflowMonitor,
+		NetflowSampler:       eth.NetflowSampler,
+		AllowedVlans:         eth.AllowedVlans,
+		NativeVlan:           eth.NativeVlan,
+	}
+}
+
+// InterfaceList is the model of the ndfc_interfaces data source.
+type InterfaceList struct {
+	Id            types.String                       `tfsdk:"id"`
+	Timeouts      timeouts.Value                     `tfsdk:"timeouts"`
+	FabricName    types.String                       `tfsdk:"fabric_name"`
+	SerialNumber  types.String                       `tfsdk:"serial_number"`
+	Policy        types.String                       `tfsdk:"policy"`
+	NameRegex     types.String                       `tfsdk:"name_regex"`
+	InterfaceType types.String                       `tfsdk:"interface_type"`
+	Interfaces    map[string]InterfaceListInterfaces `tfsdk:"interfaces"`
+}
+
+type InterfaceListInterfaces struct {
+	SerialNumber  types.String `tfsdk:"serial_number"`
+	InterfaceName types.String `tfsdk:"interface_name"`
+	InterfaceType types.String `tfsdk:"interface_type"`
+	Policy        types.String `tfsdk:"policy"`
+	AdminState    types.Bool   `tfsdk:"admin_state"`
+	OperState     types.String `tfsdk:"oper_state"`
+	Description   types.String `tfsdk:"description"`
+	Vrf           types.String `tfsdk:"vrf"`
+	AllowedVlans  types.String `tfsdk:"allowed_vlans"`
+	NativeVlan    types.Int64  `tfsdk:"native_vlan"`
+}
+
+// stringOrNull returns a null value for empty strings.
+func stringOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+// fromInterfaceDetails sets the interfaces matching the policy, name and
+// type filters, keyed by "<serial_number>:<interface_name>". re is the
+// compiled name_regex, nil if unset.
+func (data *InterfaceList) fromInterfaceDetails(details []ndfc.InterfaceDetail, re *regexp.Regexp) {
+	data.Interfaces = make(map[string]InterfaceListInterfaces)
+	for _, d := range details {
+		if re != nil && !re.MatchString(d.IfName) {
+			continue
+		}
+		if !data.Policy.IsNull() && data.Policy.ValueString() != d.Policy {
+			continue
+		}
+		if !data.InterfaceType.IsNull() && data.InterfaceType.ValueString() != d.Type {
+			continue
+		}
+		item := InterfaceListInterfaces{
+			SerialNumber:  types.StringValue(d.SerialNumber),
+			InterfaceName: types.StringValue(d.IfName),
+			InterfaceType: types.StringValue(d.Type),
+			Policy:        stringOrNull(d.Policy),
+			AdminState:    types.BoolValue(d.AdminState == "up"),
+			OperState:     stringOrNull(d.OperState),
+			Description:   stringOrNull(d.Description),
+			Vrf:           stringOrNull(d.Vrf),
+			AllowedVlans:  stringOrNull(d.AllowedVlans),
+			NativeVlan:    types.Int64Null(),
+		}
+		if d.NativeVlan != 0 {
+			item.NativeVlan = types.Int64Value(d.NativeVlan)
+		}
+		data.Interfaces[d.SerialNumber+":"+d.IfName] = item
+	}
+}
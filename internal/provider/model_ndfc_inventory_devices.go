@@ -0,0 +1,128 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
+)
+
+type InventoryDevices struct {
+	Id             types.String                       `tfsdk:"id"`
+	Timeouts       timeouts.Value                     `tfsdk:"timeouts"`
+	FabricName     types.String                       `tfsdk:"fabric_name"`
+	Username       types.String                       `tfsdk:"username"`
+	Password       types.String                       `tfsdk:"password"`
+	MaxHops        types.Int64                        `tfsdk:"max_hops"`
+	PreserveConfig types.Bool                         `tfsdk:"preserve_config"`
+	Devices        map[string]InventoryDevicesDevices `tfsdk:"devices"`
+}
+
+type InventoryDevicesDevices struct {
+	Role         types.String `tfsdk:"role"`
+	SerialNumber types.String `tfsdk:"serial_number"`
+	IpAddress    types.String `tfsdk:"ip_address"`
+	Hostname     types.String `tfsdk:"hostname"`
+}
+
+func (data InventoryDevices) discoveryOptions() ndfc.DiscoveryOptions {
+	return ndfc.DiscoveryOptions{
+		Username:       data.Username.ValueString(),
+		Password:       data.Password.ValueString(),
+		MaxHops:        data.MaxHops.ValueInt64(),
+		PreserveConfig: data.PreserveConfig.ValueBool(),
+	}
+}
+
+// seedIPs returns the sorted keys of devices.
+func seedIPs(devices map[string]InventoryDevicesDevices) []string {
+	ips := make([]string, 0, len(devices))
+	for ip := range devices {
+		ips = append(ips, ip)
+	}
+	sort.Strings(ips)
+	return ips
+}
+
+// serialNumbers returns the serial numbers of the devices at ips.
+func (data InventoryDevices) serialNumbers(ips []string) []string {
+	serials := make([]string, 0, len(ips))
+	for _, ip := range ips {
+		if serial := data.Devices[ip].SerialNumber.ValueString(); serial != "" {
+			serials = append(serials, serial)
+		}
+	}
+	return serials
+}
+
+// fromSwitch sets the computed attributes of the device at ip.
+func (data *InventoryDevices) fromSwitch(ip string, sw ndfc.Switch) {
+	device := data.Devices[ip]
+	device.Role = types.StringValue(sw.Role)
+	device.SerialNumber = types.StringValue(sw.SerialNumber)
+	device.IpAddress = types.StringValue(sw.IpAddress)
+	device.Hostname = types.StringValue(sw.Hostname)
+	data.Devices[ip] = device
+}
+
+// nullUnknown sets the computed attributes of devices that have not been
+// discovered yet to null, so that they can be kept in the state. Read fills
+// them in by seed IP once the switches have been discovered.
+func (data *InventoryDevices) nullUnknown() {
+	for ip, device := range data.Devices {
+		if device.SerialNumber.IsUnknown() {
+			device.SerialNumber = types.StringNull()
+		}
+		if device.IpAddress.IsUnknown() {
+			device.IpAddress = types.StringNull()
+		}
+		if device.Hostname.IsUnknown() {
+			device.Hostname = types.StringNull()
+		}
+		data.Devices[ip] = device
+	}
+}
+
+// fromSwitches updates the devices from the fabric inventory. Devices that
+// are no longer part of the fabric are dropped. Without any devices, as after
+// an import, all switches of the fabric are added.
+func (data *InventoryDevices) fromSwitches(switches []ndfc.Switch) {
+	if data.Devices == nil {
+		data.Devices = make(map[string]InventoryDevicesDevices, len(switches))
+		for _, sw := range switches {
+			data.fromSwitch(sw.IpAddress, sw)
+		}
+		return
+	}
+	for ip, device := range data.Devices {
+		found := false
+		for _, sw := range switches {
+			if sw.IpAddress == ip || (sw.SerialNumber != "" && sw.SerialNumber == device.SerialNumber.ValueString()) {
+				data.fromSwitch(ip, sw)
+				found = true
+				break
+			}
+		}
+		if !found {
+			delete(data.Devices, ip)
+		}
+	}
+}
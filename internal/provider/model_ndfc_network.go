@@ -24,8 +24,13 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"sort"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/helpers"
 	"github.com/tidwall/gjson"
 	"github.com/tidwall/sjson"
 )
@@ -35,6 +40,7 @@ import (
 //template:begin types
 type Network struct {
 	Id                       types.String              `tfsdk:"id"`
+	Timeouts                 timeouts.Value            `tfsdk:"timeouts"`
 	FabricName               types.String              `tfsdk:"fabric_name"`
 	NetworkName              types.String              `tfsdk:"network_name"`
 	DisplayName              types.String              `tfsdk:"display_name"`
@@ -66,6 +72,8 @@ type Network struct {
 	VlanNetflowMonitor       types.String              `tfsdk:"vlan_netflow_monitor"`
 	L3GatwayBorder           types.Bool                `tfsdk:"l3_gatway_border"`
 	Attachments              []NetworkAttachments      `tfsdk:"attachments"`
+	UnmanagedAttachments     types.List                `tfsdk:"unmanaged_attachments"`
+	AttachmentStatus         types.Map                 `tfsdk:"attachment_status"`
 }
 
 type NetworkDhcpRelayServers struct {
@@ -90,6 +98,43 @@ func (data Network) getPath() string {
 
 //template:end getPath
 
+//template:begin fieldPaths
+func (data Network) fieldPaths() map[string]path.Path {
+	return map[string]path.Path{
+		"fabric":                   path.Root("fabric_name"),
+		"networkName":              path.Root("network_name"),
+		"displayName":              path.Root("display_name"),
+		"networkId":                path.Root("network_id"),
+		"networkTemplate":          path.Root("network_template"),
+		"networkExtensionTemplate": path.Root("network_extension_template"),
+		"vrf":                      path.Root("vrf_name"),
+		"gatewayIpAddress":         path.Root("gateway_ipv4_address"),
+		"vlanId":                   path.Root("vlan_id"),
+		"gatewayIpV6Address":       path.Root("gateway_ipv6_address"),
+		"isLayer2Only":             path.Root("layer2_only"),
+		"suppressArp":              path.Root("arp_suppression"),
+		"enableIR":                 path.Root("ingress_replication"),
+		"mcastGroup":               path.Root("multicast_group"),
+		"loopbackId":               path.Root("dhcp_relay_loopback_id"),
+		"vrfVlanName":              path.Root("vlan_name"),
+		"intfDescription":          path.Root("interface_description"),
+		"mtu":                      path.Root("mtu"),
+		"tag":                      path.Root("loopback_routing_tag"),
+		"trmEnabled":               path.Root("trm"),
+		"secondaryGW1":             path.Root("secondary_gateway_1"),
+		"secondaryGW2":             path.Root("secondary_gateway_2"),
+		"secondaryGW3":             path.Root("secondary_gateway_3"),
+		"secondaryGW4":             path.Root("secondary_gateway_4"),
+		"rtBothAuto":               path.Root("route_target_both"),
+		"ENABLE_NETFLOW":           path.Root("netflow"),
+		"SVI_NETFLOW_MONITOR":      path.Root("svi_netflow_monitor"),
+		"VLAN_NETFLOW_MONITOR":     path.Root("vlan_netflow_monitor"),
+		"enableL3OnBorder":         path.Root("l3_gatway_border"),
+	}
+}
+
+//template:end fieldPaths
+
 func (data Network) toBody(ctx context.Context) string {
 	body := ""
 	if !data.FabricName.IsNull() && !data.FabricName.IsUnknown() {
@@ -195,12 +240,18 @@ func (data Network) toBody(ctx context.Context) string {
 	return body
 }
 
-func (data Network) toBodyAttachments(ctx context.Context, attachments gjson.Result) string {
+// toBodyAttachments returns the body attaching the network to the switches of
+// its attachments and detaching it from all other switches listed in
+// attachments. If serialNumbers is not nil, only those switches are included.
+func (data Network) toBodyAttachments(ctx context.Context, attachments gjson.Result, serialNumbers map[string]bool) string {
 	body := ""
 	body, _ = sjson.Set(body, "0.networkName", data.NetworkName.ValueString())
 	body, _ = sjson.Set(body, "0.lanAttachList", []interface{}{})
 	attachments.Get("0.lanAttachList").ForEach(func(k, v gjson.Result) bool {
 		serialNumber := v.Get("switchSerialNo").String()
+		if serialNumbers != nil && !serialNumbers[serialNumber] {
+			return true
+		}
 
 		itemBody := ""
 		if !data.FabricName.IsNull() && !data.FabricName.IsUnknown() {
@@ -409,59 +460,138 @@ func (data *Network) fromBody(ctx context.Context, res gjson.Result) {
 	}
 }
 
-func (data *Network) fromBodyAttachments(ctx context.Context, res gjson.Result, all bool) {
-	serialsToRemove := []string{}
+// managedSerialNumbers returns the serial numbers of the switches in the
+// attachments, or nil if the attachments are not managed.
+func (data Network) managedSerialNumbers() map[string]bool {
+	if data.Attachments == nil {
+		return nil
+	}
+	serialNumbers := make(map[string]bool, len(data.Attachments))
+	for _, item := range data.Attachments {
+		serialNumbers[item.SerialNumber.ValueString()] = true
+	}
+	return serialNumbers
+}
+
+// unmanagedSerialNumbers returns the serial numbers of the switches in
+// unmanaged_attachments.
+func (data Network) unmanagedSerialNumbers(ctx context.Context) map[string]bool {
+	var items []string
+	data.UnmanagedAttachments.ElementsAs(ctx, &items, false)
+	serialNumbers := make(map[string]bool, len(items))
+	for _, item := range items {
+		serialNumbers[item] = true
+	}
+	return serialNumbers
+}
+
+// fromBodyManagedAttachments updates the attachments like fromBodyAttachments,
+// but leaves out the switches in unmanaged_attachments, whose attachments are
+// managed elsewhere, e.g. with `ndfc_network_attachment`. Any other switch
+// attached or detached outside of Terraform shows up as a diff.
+func (data *Network) fromBodyManagedAttachments(ctx context.Context, res gjson.Result) {
+	unmanaged := data.unmanagedSerialNumbers(ctx)
+	data.fromBodyAttachments(ctx, res)
+	attachments := make([]NetworkAttachments, 0, len(data.Attachments))
+	for _, item := range data.Attachments {
+		if !unmanaged[item.SerialNumber.ValueString()] {
+			attachments = append(attachments, item)
+		}
+	}
+	data.Attachments = attachments
+	data.AttachmentStatus = helpers.FilterAttachmentStatus(data.AttachmentStatus, data.managedSerialNumbers())
+}
+
+// fromBodyAttachments updates the attachments from res, the attachments of
+// the network to all switches of the fabric. Switches attached or detached
+// outside of Terraform are added to or removed from the attachments, so that
+// they show up as a diff. Attributes NDFC does not return are kept.
+func (data *Network) fromBodyAttachments(ctx context.Context, res gjson.Result) {
+	current := make(map[string]NetworkAttachments, len(data.Attachments))
+	for _, item := range data.Attachments {
+		current[item.SerialNumber.ValueString()] = item
+	}
+	var attachments []NetworkAttachments
 	res.Get("0.lanAttachList").ForEach(func(k, v gjson.Result) bool {
+		if !v.Get("isLanAttached").Bool() {
+			return true
+		}
 		serialNumber := v.Get("switchSerialNo").String()
-		attached := v.Get("isLanAttached").Bool()
-		if all {
-			if attached {
-				var item NetworkAttachments
-				if value := v.Get("switchSerialNo"); value.Exists() {
-					item.SerialNumber = types.StringValue(value.String())
-				} else {
-					item.SerialNumber = types.StringNull()
-				}
-				if value := v.Get("portNames"); value.Exists() {
-					item.AttachSwitchPorts = types.StringValue(value.String())
-				} else {
-					item.AttachSwitchPorts = types.StringNull()
-				}
-				if value := v.Get("vlanId"); value.Exists() {
-					item.VlanId = types.Int64Value(value.Int())
-				} else {
-					item.VlanId = types.Int64Null()
-				}
-				data.Attachments = append(data.Attachments, item)
+		item, ok := current[serialNumber]
+		if !ok {
+			item = NetworkAttachments{
+				SerialNumber:      types.StringValue(serialNumber),
+				AttachSwitchPorts: types.StringNull(),
+				DetachSwitchPorts: types.StringNull(),
+				VlanId:            types.Int64Value(-1),
+				FreeformConfig:    types.StringNull(),
 			}
-		} else {
-			for _, item := range data.Attachments {
-				if item.SerialNumber.ValueString() == serialNumber {
-					if attached {
-						if value := v.Get("vlanId"); value.Exists() {
-							item.VlanId = types.Int64Value(value.Int())
-						} else {
-							item.VlanId = types.Int64Null()
-						}
-						if value := v.Get("portNames"); value.Exists() {
-							item.AttachSwitchPorts = types.StringValue(value.String())
-						} else {
-							item.AttachSwitchPorts = types.StringNull()
-						}
-					} else {
-						serialsToRemove = append(serialsToRemove, serialNumber)
-					}
-				}
+		}
+		if value := v.Get("portNames").String(); !sameSwitchPorts(value, item.AttachSwitchPorts.ValueString()) {
+			if value != "" {
+				item.AttachSwitchPorts = types.StringValue(value)
+			} else {
+				item.AttachSwitchPorts = types.StringNull()
 			}
 		}
+		// -1 stands for the VLAN of the network
+		if value := v.Get("vlanId"); value.Exists() && !(item.VlanId.ValueInt64() == -1 && value.Int() == data.VlanId.ValueInt64()) {
+			item.VlanId = types.Int64Value(value.Int())
+		}
+		if value := v.Get("freeformConfig"); value.Exists() {
+			if value.String() != "" {
+				item.FreeformConfig = types.StringValue(value.String())
+			} else {
+				item.FreeformConfig = types.StringNull()
+			}
+		}
+		attachments = append(attachments, item)
 		return true
 	})
-	for i := range data.Attachments {
-		for _, serial := range serialsToRemove {
-			if data.Attachments[i].SerialNumber.ValueString() == serial {
-				data.Attachments = append(data.Attachments[:i], data.Attachments[i+1:]...)
-				break
+	data.Attachments = attachments
+	data.AttachmentStatus = helpers.AttachmentStatus(res.Get("0"))
+}
+
+// sameSwitchPorts reports whether two comma separated lists of switch ports
+// hold the same ports, ignoring order, case and whitespace.
+func sameSwitchPorts(a, b string) bool {
+	split := func(s string) []string {
+		var ports []string
+		for _, port := range strings.Split(s, ",") {
+			if port = strings.ToLower(strings.TrimSpace(port)); port != "" {
+				ports = append(ports, port)
 			}
 		}
+		sort.Strings(ports)
+		return ports
+	}
+	return strings.Join(split(a), ",") == strings.Join(split(b), ",")
+}
+
+// changedAttachments returns the serial numbers of the switches whose
+// attachment differs between data and state: switches added to or removed
+// from the attachments and switches with changed ports, VLAN or freeform
+// config.
+func (data Network) changedAttachments(state Network) map[string]bool {
+	current := make(map[string]NetworkAttachments, len(state.Attachments))
+	for _, item := range state.Attachments {
+		current[item.SerialNumber.ValueString()] = item
+	}
+	changed := make(map[string]bool)
+	for _, item := range data.Attachments {
+		serialNumber := item.SerialNumber.ValueString()
+		old, ok := current[serialNumber]
+		delete(current, serialNumber)
+		if !ok ||
+			!sameSwitchPorts(item.AttachSwitchPorts.ValueString(), old.AttachSwitchPorts.ValueString()) ||
+			!sameSwitchPorts(item.DetachSwitchPorts.ValueString(), old.DetachSwitchPorts.ValueString()) ||
+			!item.VlanId.Equal(old.VlanId) ||
+			!item.FreeformConfig.Equal(old.FreeformConfig) {
+			changed[serialNumber] = true
+		}
+	}
+	for serialNumber := range current {
+		changed[serialNumber] = true
 	}
+	return changed
 }
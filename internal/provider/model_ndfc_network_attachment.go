@@ -0,0 +1,137 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+type NetworkAttachment struct {
+	Id                types.String   `tfsdk:"id"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+	FabricName        types.String   `tfsdk:"fabric_name"`
+	NetworkName       types.String   `tfsdk:"network_name"`
+	SerialNumber      types.String   `tfsdk:"serial_number"`
+	VlanId            types.Int64    `tfsdk:"vlan_id"`
+	AttachSwitchPorts types.String   `tfsdk:"attach_switch_ports"`
+	DetachSwitchPorts types.String   `tfsdk:"detach_switch_ports"`
+	FreeformConfig    types.String   `tfsdk:"freeform_config"`
+	ExtensionValues   types.String   `tfsdk:"extension_values"`
+	Deploy            types.Bool     `tfsdk:"deploy"`
+	Status            types.String   `tfsdk:"status"`
+}
+
+func (data NetworkAttachment) getId() string {
+	return data.FabricName.ValueString() + "/" + data.NetworkName.ValueString() + "/" + data.SerialNumber.ValueString()
+}
+
+// equal reports whether data and other configure the same attachment,
+// ignoring the timeouts and the status.
+func (data NetworkAttachment) equal(other NetworkAttachment) bool {
+	return data.Id.Equal(other.Id) &&
+		data.FabricName.Equal(other.FabricName) &&
+		data.NetworkName.Equal(other.NetworkName) &&
+		data.SerialNumber.Equal(other.SerialNumber) &&
+		data.VlanId.Equal(other.VlanId) &&
+		data.AttachSwitchPorts.Equal(other.AttachSwitchPorts) &&
+		data.DetachSwitchPorts.Equal(other.DetachSwitchPorts) &&
+		data.FreeformConfig.Equal(other.FreeformConfig) &&
+		data.ExtensionValues.Equal(other.ExtensionValues) &&
+		data.Deploy.Equal(other.Deploy)
+}
+
+func (data NetworkAttachment) fieldPaths() map[string]path.Path {
+	return map[string]path.Path{
+		"vlan":              path.Root("vlan_id"),
+		"vlanId":            path.Root("vlan_id"),
+		"switchPorts":       path.Root("attach_switch_ports"),
+		"detachSwitchPorts": path.Root("detach_switch_ports"),
+		"freeformConfig":    path.Root("freeform_config"),
+		"extensionValues":   path.Root("extension_values"),
+	}
+}
+
+// toBody returns the body attaching the network to the switch, or detaching
+// it if attach is false.
+func (data NetworkAttachment) toBody(ctx context.Context, attach bool) string {
+	itemBody := ""
+	itemBody, _ = sjson.Set(itemBody, "fabric", data.FabricName.ValueString())
+	itemBody, _ = sjson.Set(itemBody, "networkName", data.NetworkName.ValueString())
+	itemBody, _ = sjson.Set(itemBody, "serialNumber", data.SerialNumber.ValueString())
+	if !data.VlanId.IsNull() && !data.VlanId.IsUnknown() {
+		itemBody, _ = sjson.Set(itemBody, "vlan", data.VlanId.ValueInt64())
+	}
+	if attach {
+		if !data.AttachSwitchPorts.IsNull() && !data.AttachSwitchPorts.IsUnknown() {
+			itemBody, _ = sjson.Set(itemBody, "switchPorts", data.AttachSwitchPorts.ValueString())
+		}
+		if !data.DetachSwitchPorts.IsNull() && !data.DetachSwitchPorts.IsUnknown() {
+			itemBody, _ = sjson.Set(itemBody, "detachSwitchPorts", data.DetachSwitchPorts.ValueString())
+		}
+		if !data.FreeformConfig.IsNull() && !data.FreeformConfig.IsUnknown() {
+			itemBody, _ = sjson.Set(itemBody, "freeformConfig", data.FreeformConfig.ValueString())
+		}
+		if !data.ExtensionValues.IsNull() && !data.ExtensionValues.IsUnknown() {
+			itemBody, _ = sjson.Set(itemBody, "extensionValues", data.ExtensionValues.ValueString())
+		}
+	}
+	itemBody, _ = sjson.Set(itemBody, "deployment", attach)
+
+	body := ""
+	body, _ = sjson.Set(body, "0.networkName", data.NetworkName.ValueString())
+	body, _ = sjson.SetRaw(body, "0.lanAttachList.-1", itemBody)
+	return body
+}
+
+// fromBody updates data from res, the entry of the switch in the
+// lanAttachList of the network. vlan is the VLAN of the network, which is kept
+// as -1. detach_switch_ports is not returned by NDFC and is kept.
+func (data *NetworkAttachment) fromBody(ctx context.Context, res gjson.Result, vlan int64) {
+	if value := res.Get("vlanId"); value.Exists() && !(data.VlanId.ValueInt64() == -1 && value.Int() == vlan) {
+		data.VlanId = types.Int64Value(value.Int())
+	}
+	if value := res.Get("portNames"); value.Exists() && value.String() != "" {
+		if !sameSwitchPorts(value.String(), data.AttachSwitchPorts.ValueString()) {
+			data.AttachSwitchPorts = types.StringValue(value.String())
+		}
+	} else {
+		data.AttachSwitchPorts = types.StringNull()
+	}
+	if value := res.Get("freeformConfig"); value.Exists() && value.String() != "" {
+		data.FreeformConfig = types.StringValue(value.String())
+	} else {
+		data.FreeformConfig = types.StringNull()
+	}
+	if value := res.Get("extensionValues"); value.Exists() && value.String() != "" {
+		data.ExtensionValues = types.StringValue(value.String())
+	} else {
+		data.ExtensionValues = types.StringNull()
+	}
+	if value := res.Get("lanAttachState"); value.Exists() {
+		data.Status = types.StringValue(value.String())
+	} else {
+		data.Status = types.StringValue(ndfc.StateNA)
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
+)
+
+type Switches struct {
+	Id            types.String                `tfsdk:"id"`
+	Timeouts      timeouts.Value              `tfsdk:"timeouts"`
+	FabricName    types.String                `tfsdk:"fabric_name"`
+	HostnameRegex types.String                `tfsdk:"hostname_regex"`
+	Role          types.String                `tfsdk:"role"`
+	Switches      map[string]SwitchesSwitches `tfsdk:"switches"`
+}
+
+type SwitchesSwitches struct {
+	SerialNumber        types.String `tfsdk:"serial_number"`
+	IpAddress           types.String `tfsdk:"ip_address"`
+	Role                types.String `tfsdk:"role"`
+	Model               types.String `tfsdk:"model"`
+	Version             types.String `tfsdk:"version"`
+	VpcPeerSerialNumber types.String `tfsdk:"vpc_peer_serial_number"`
+	VpcPeerHostname     types.String `tfsdk:"vpc_peer_hostname"`
+}
+
+// fromSwitches sets the switches matching the hostname and role filters,
+// keyed by hostname, or by serial number if the hostname is empty. re is the
+// compiled hostname_regex, nil if unset. Switches sharing a hostname are
+// reported as an error, as they cannot be told apart.
+func (data *Switches) fromSwitches(switches []ndfc.Switch, re *regexp.Regexp) error {
+	hostnames := make(map[string]string, len(switches))
+	for _, sw := range switches {
+		hostnames[sw.SerialNumber] = sw.Hostname
+	}
+	data.Switches = make(map[string]SwitchesSwitches)
+	for _, sw := range switches {
+		if re != nil && !re.MatchString(sw.Hostname) {
+			continue
+		}
+		if !data.Role.IsNull() && data.Role.ValueString() != sw.Role {
+			continue
+		}
+		item := SwitchesSwitches{
+			SerialNumber:        types.StringValue(sw.SerialNumber),
+			IpAddress:           types.StringValue(sw.IpAddress),
+			Role:                types.StringValue(sw.Role),
+			Model:               types.StringValue(sw.Model),
+			Version:             types.StringValue(sw.Version),
+			VpcPeerSerialNumber: types.StringNull(),
+			VpcPeerHostname:     types.StringNull(),
+		}
+		if sw.VpcPeer != "" {
+			item.VpcPeerSerialNumber = types.StringValue(sw.VpcPeer)
+			item.VpcPeerHostname = types.StringValue(hostnames[sw.VpcPeer])
+		}
+		key := sw.Hostname
+		if key == "" {
+			key = sw.SerialNumber
+		}
+		if other, ok := data.Switches[key]; ok {
+			return fmt.Errorf("switches %s and %s have the same hostname %s", other.SerialNumber.ValueString(), sw.SerialNumber, key)
+		}
+		data.Switches[key] = item
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+type VpcPair struct {
+	Id                types.String   `tfsdk:"id"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+	Peer1SerialNumber types.String   `tfsdk:"peer1_serial_number"`
+	Peer2SerialNumber types.String   `tfsdk:"peer2_serial_number"`
+	VirtualPeerLink   types.Bool     `tfsdk:"virtual_peer_link"`
+	ConsistencyStatus types.String   `tfsdk:"consistency_status"`
+}
+
+func (data VpcPair) getPath() string {
+	return ndfc.VpcPairPath
+}
+
+func (data VpcPair) fieldPaths() map[string]path.Path {
+	return map[string]path.Path{
+		"peerOneId":          path.Root("peer1_serial_number"),
+		"peerTwoId":          path.Root("peer2_serial_number"),
+		"useVirtualPeerlink": path.Root("virtual_peer_link"),
+	}
+}
+
+func (data VpcPair) toBody(ctx context.Context) string {
+	body := ""
+	if !data.Peer1SerialNumber.IsNull() && !data.Peer1SerialNumber.IsUnknown() {
+		body, _ = sjson.Set(body, "peerOneId", data.Peer1SerialNumber.ValueString())
+	}
+	if !data.Peer2SerialNumber.IsNull() && !data.Peer2SerialNumber.IsUnknown() {
+		body, _ = sjson.Set(body, "peerTwoId", data.Peer2SerialNumber.ValueString())
+	}
+	if !data.VirtualPeerLink.IsNull() && !data.VirtualPeerLink.IsUnknown() {
+		body, _ = sjson.Set(body, "useVirtualPeerlink", data.VirtualPeerLink.ValueBool())
+	}
+	return body
+}
+
+// fromBody reads the pair. NDFC may return the peers in either order, they
+// are kept in the order of the configuration.
+func (data *VpcPair) fromBody(ctx context.Context, res gjson.Result) {
+	one, two := res.Get("peerOneId").String(), res.Get("peerTwoId").String()
+	if data.Peer1SerialNumber.ValueString() == two {
+		one, two = two, one
+	}
+	data.Peer1SerialNumber = types.StringValue(one)
+	data.Peer2SerialNumber = types.StringValue(two)
+	if value := res.Get("useVirtualPeerlink"); value.Exists() {
+		data.VirtualPeerLink = types.BoolValue(value.Bool())
+	} else {
+		data.VirtualPeerLink = types.BoolNull()
+	}
+}
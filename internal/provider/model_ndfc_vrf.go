@@ -22,10 +22,13 @@ package provider
 //template:begin imports
 import (
 	"context"
+	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/helpers"
 	"github.com/tidwall/gjson"
 	"github.com/tidwall/sjson"
 )
@@ -79,16 +82,31 @@ type VRF struct {
 	RouteTargetExportCloudEvpn  types.String     `tfsdk:"route_target_export_cloud_evpn"`
 	Timeout                     types.String     `tfsdk:"timeout"`
 	Attachments                 []VRFAttachments `tfsdk:"attachments"`
+	UnmanagedAttachments        types.List       `tfsdk:"unmanaged_attachments"`
+	AttachmentStatus            types.Map        `tfsdk:"attachment_status"`
 }
 
 type VRFAttachments struct {
-	SerialNumber   types.String `tfsdk:"serial_number"`
-	DeployConfig   types.Bool   `tfsdk:"deploy_config"`
-	VlanId         types.Int64  `tfsdk:"vlan_id"`
-	FreeformConfig types.String `tfsdk:"freeform_config"`
-	LoopbackId     types.Int64  `tfsdk:"loopback_id"`
-	LoopbackIpv4   types.String `tfsdk:"loopback_ipv4"`
-	LoopbackIpv6   types.String `tfsdk:"loopback_ipv6"`
+	SerialNumber   types.String            `tfsdk:"serial_number"`
+	DeployConfig   types.Bool              `tfsdk:"deploy_config"`
+	VlanId         types.Int64             `tfsdk:"vlan_id"`
+	FreeformConfig types.String            `tfsdk:"freeform_config"`
+	LoopbackId     types.Int64             `tfsdk:"loopback_id"`
+	LoopbackIpv4   types.String            `tfsdk:"loopback_ipv4"`
+	LoopbackIpv6   types.String            `tfsdk:"loopback_ipv6"`
+	VrfLite        []VRFAttachmentsVrfLite `tfsdk:"vrf_lite"`
+}
+
+type VRFAttachmentsVrfLite struct {
+	InterfaceName types.String `tfsdk:"interface_name"`
+	Dot1qId       types.Int64  `tfsdk:"dot1q_id"`
+	Ipv4Address   types.String `tfsdk:"ipv4_address"`
+	NeighborIpv4  types.String `tfsdk:"neighbor_ipv4"`
+	NeighborAsn   types.String `tfsdk:"neighbor_asn"`
+	Ipv6Address   types.String `tfsdk:"ipv6_address"`
+	NeighborIpv6  types.String `tfsdk:"neighbor_ipv6"`
+	PeerVrfName   types.String `tfsdk:"peer_vrf_name"`
+	AutoVrfLite   types.Bool   `tfsdk:"auto_vrf_lite"`
 }
 
 //template:end types
@@ -97,6 +115,54 @@ type VRFAttachments struct {
 
 //template:end getPath
 
+//template:begin fieldPaths
+func (data VRF) fieldPaths() map[string]path.Path {
+	return map[string]path.Path{
+		"fabric":                          path.Root("fabric_name"),
+		"vrfName":                         path.Root("vrf_name"),
+		"vrfTemplate":                     path.Root("vrf_template"),
+		"vrfExtensionTemplate":            path.Root("vrf_extension_template"),
+		"vrfId":                           path.Root("vrf_id"),
+		"vrfVlanId":                       path.Root("vlan_id"),
+		"vrfVlanName":                     path.Root("vlan_name"),
+		"vrfIntfDescription":              path.Root("interface_description"),
+		"vrfDescription":                  path.Root("vrf_description"),
+		"mtu":                             path.Root("mtu"),
+		"tag":                             path.Root("loopback_routing_tag"),
+		"vrfRouteMap":                     path.Root("redistribute_direct_route_map"),
+		"maxBgpPaths":                     path.Root("max_bgp_paths"),
+		"maxIbgpPaths":                    path.Root("max_ibgp_paths"),
+		"ipv6LinkLocalFlag":               path.Root("ipv6_link_local"),
+		"trmEnabled":                      path.Root("trm"),
+		"isRPAbsent":                      path.Root("no_rp"),
+		"isRPExternal":                    path.Root("rp_external"),
+		"rpAddress":                       path.Root("rp_address"),
+		"loopbackNumber":                  path.Root("rp_loopback_id"),
+		"L3VniMcastGroup":                 path.Root("underlay_multicast_address"),
+		"multicastGroup":                  path.Root("overlay_multicast_groups"),
+		"mvpnInterAs":                     path.Root("mvpn_inter_as"),
+		"trmBGWMSiteEnabled":              path.Root("trm_bgw_msite"),
+		"advertiseHostRouteFlag":          path.Root("advertise_host_routes"),
+		"advertiseDefaultRouteFlag":       path.Root("advertise_default_route"),
+		"configureStaticDefaultRouteFlag": path.Root("configure_static_default_route"),
+		"bgpPassword":                     path.Root("bgp_password"),
+		"bgpPasswordKeyType":              path.Root("bgp_password_type"),
+		"ENABLE_NETFLOW":                  path.Root("netflow"),
+		"NETFLOW_MONITOR":                 path.Root("netflow_monitor"),
+		"disableRtAuto":                   path.Root("disable_rt_auto"),
+		"routeTargetImport":               path.Root("route_target_import"),
+		"routeTargetExport":               path.Root("route_target_export"),
+		"routeTargetImportEvpn":           path.Root("route_target_import_evpn"),
+		"routeTargetExportEvpn":           path.Root("route_target_export_evpn"),
+		"routeTargetImportMvpn":           path.Root("route_target_import_mvpn"),
+		"routeTargetExportMvpn":           path.Root("route_target_export_mvpn"),
+		"cloudRouteTargetImportEvpn":      path.Root("route_target_import_cloud_evpn"),
+		"cloudRouteTargetExportEvpn":      path.Root("route_target_export_cloud_evpn"),
+	}
+}
+
+//template:end fieldPaths
+
 func (data VRF) toBody(ctx context.Context) string {
 	body := ""
 	if !data.FabricName.IsNull() && !data.FabricName.IsUnknown() {
@@ -265,6 +331,11 @@ func (data VRF) toBodyAttachments(ctx context.Context, attachments gjson.Result,
 			if instanceBody != "" {
 				itemBody, _ = sjson.Set(itemBody, "instanceValues", instanceBody)
 			}
+			if item.VrfLite != nil {
+				itemBody, _ = sjson.Set(itemBody, "extensionValues", vrfLiteExtensionValues(item.VrfLite))
+			} else {
+				itemBody, _ = sjson.Set(itemBody, "extensionValues", "")
+			}
 			if forced_dettach {
 				itemBody, _ = sjson.Set(itemBody, "deployment", false)
 			} else {
@@ -490,41 +561,179 @@ func (data *VRF) fromBody(ctx context.Context, res gjson.Result) {
 }
 
 
-func (data *VRF) fromBodyAttachments(ctx context.Context, res gjson.Result, all bool) {
-	res.Get("0").ForEach(func(k, v gjson.Result) bool {
+// managedSerialNumbers returns the serial numbers of the switches in the
+// attachments, or nil if the attachments are not managed.
+func (data VRF) managedSerialNumbers() map[string]bool {
+	if data.Attachments == nil {
+		return nil
+	}
+	serialNumbers := make(map[string]bool, len(data.Attachments))
+	for _, item := range data.Attachments {
+		serialNumbers[item.SerialNumber.ValueString()] = true
+	}
+	return serialNumbers
+}
+
+// unmanagedSerialNumbers returns the serial numbers of the switches in
+// unmanaged_attachments.
+func (data VRF) unmanagedSerialNumbers(ctx context.Context) map[string]bool {
+	var items []string
+	data.UnmanagedAttachments.ElementsAs(ctx, &items, false)
+	serialNumbers := make(map[string]bool, len(items))
+	for _, item := range items {
+		serialNumbers[item] = true
+	}
+	return serialNumbers
+}
+
+// fromBodyManagedAttachments updates the attachments like fromBodyAttachments,
+// but leaves out the switches in unmanaged_attachments, whose attachments are
+// managed elsewhere, e.g. with `ndfc_vrf_attachment`. Any other switch
+// attached or detached outside of Terraform shows up as a diff.
+func (data *VRF) fromBodyManagedAttachments(ctx context.Context, res gjson.Result) {
+	unmanaged := data.unmanagedSerialNumbers(ctx)
+	data.fromBodyAttachments(ctx, res)
+	attachments := make([]VRFAttachments, 0, len(data.Attachments))
+	for _, item := range data.Attachments {
+		if !unmanaged[item.SerialNumber.ValueString()] {
+			attachments = append(attachments, item)
+		}
+	}
+	data.Attachments = attachments
+	data.AttachmentStatus = helpers.FilterAttachmentStatus(data.AttachmentStatus, data.managedSerialNumbers())
+}
+
+// fromBodyAttachments updates the attachments from res, the attachments of
+// the VRF to all switches of the fabric. Switches attached or detached
+// outside of Terraform are added to or removed from the attachments, so that
+// they show up as a diff. Attributes NDFC does not return are kept.
+func (data *VRF) fromBodyAttachments(ctx context.Context, res gjson.Result) {
+	current := make(map[string]VRFAttachments, len(data.Attachments))
+	for _, item := range data.Attachments {
+		current[item.SerialNumber.ValueString()] = item
+	}
+	var attachments []VRFAttachments
+	res.Get("0.lanAttachList").ForEach(func(k, v gjson.Result) bool {
+		if !v.Get("isLanAttached").Bool() {
+			return true
+		}
 		serialNumber := v.Get("switchSerialNo").String()
-		log.Printf("Akash v %v", v)
-		log.Printf("Akash data.Attachments %v", data.Attachments)
-		for _, item := range data.Attachments {
-			if item.SerialNumber.ValueString() == serialNumber {
-				if value := v.Get("switchSerialNo"); value.Exists() {
-					item.SerialNumber = types.StringValue(value.String())
-				} else {
-					item.SerialNumber = types.StringNull()
-				}
-				if value := v.Get("vlanId"); value.Exists() {
-					item.VlanId = types.Int64Value(value.Int())
-				} else {
-					item.VlanId = types.Int64Null()
-				}
-				if value := v.Get("instanceValues.loopbackId"); value.Exists() {
-					item.LoopbackId = types.Int64Value(value.Int())
-				} else {
-					item.LoopbackId = types.Int64Null()
-				}
-				if value := v.Get("instanceValues.loopbackIpAddress"); value.Exists() {
-					item.LoopbackIpv4 = types.StringValue(value.String())
-				} else {
-					item.LoopbackIpv4 = types.StringNull()
-				}
-				if value := v.Get("instanceValues.loopbackIpV6Address"); value.Exists() {
-					item.LoopbackIpv6 = types.StringValue(value.String())
-				} else {
-					item.LoopbackIpv6 = types.StringNull()
-				}
+		item, ok := current[serialNumber]
+		if !ok {
+			item = VRFAttachments{
+				SerialNumber:   types.StringValue(serialNumber),
+				DeployConfig:   types.BoolNull(),
+				VlanId:         types.Int64Null(),
+				FreeformConfig: types.StringNull(),
+				LoopbackId:     types.Int64Null(),
+				LoopbackIpv4:   types.StringNull(),
+				LoopbackIpv6:   types.StringNull(),
 			}
 		}
+		// null and -1 stand for the VLAN of the VRF
+		if value := v.Get("vlanId"); value.Exists() && !((item.VlanId.IsNull() || item.VlanId.ValueInt64() == -1) && value.Int() == data.VlanId.ValueInt64()) {
+			item.VlanId = types.Int64Value(value.Int())
+		}
+		if value := v.Get("freeformConfig"); value.Exists() {
+			if value.String() != "" {
+				item.FreeformConfig = types.StringValue(value.String())
+			} else {
+				item.FreeformConfig = types.StringNull()
+			}
+		}
+		// NDFC returns the instance values as an embedded JSON string
+		instanceValues := v.Get("instanceValues")
+		if instanceValues.Type == gjson.String {
+			instanceValues = gjson.Parse(instanceValues.String())
+		}
+		if value := instanceValues.Get("loopbackId"); value.Exists() && value.String() != "" {
+			item.LoopbackId = types.Int64Value(value.Int())
+		} else {
+			item.LoopbackId = types.Int64Null()
+		}
+		if value := instanceValues.Get("loopbackIpAddress"); value.Exists() && value.String() != "" {
+			item.LoopbackIpv4 = types.StringValue(value.String())
+		} else {
+			item.LoopbackIpv4 = types.StringNull()
+		}
+		if value := instanceValues.Get("loopbackIpV6Address"); value.Exists() && value.String() != "" {
+			item.LoopbackIpv6 = types.StringValue(value.String())
+		} else {
+			item.LoopbackIpv6 = types.StringNull()
+		}
+		// VRF Lite connections are only read if they are managed, NDFC
+		// creates some on border switches by itself
+		if value := v.Get("extensionValues"); value.Exists() && item.VrfLite != nil {
+			item.VrfLite = vrfLiteFromExtensionValues(value.String())
+		}
+		attachments = append(attachments, item)
+		return true
+	})
+	data.Attachments = attachments
+	data.AttachmentStatus = helpers.AttachmentStatus(res.Get("0"))
+}
+
+// vrfLiteExtensionValues returns the extensionValues of an attachment with
+// the given VRF Lite connections. NDFC expects the connections as JSON
+// encoded strings within the JSON encoded extension values.
+func vrfLiteExtensionValues(items []VRFAttachmentsVrfLite) string {
+	conns := ""
+	conns, _ = sjson.Set(conns, "VRF_LITE_CONN", []interface{}{})
+	for _, item := range items {
+		itemBody := ""
+		itemBody, _ = sjson.Set(itemBody, "IF_NAME", item.InterfaceName.ValueString())
+		if !item.Dot1qId.IsNull() && !item.Dot1qId.IsUnknown() {
+			itemBody, _ = sjson.Set(itemBody, "DOT1Q_ID", fmt.Sprint(item.Dot1qId.ValueInt64()))
+		} else {
+			itemBody, _ = sjson.Set(itemBody, "DOT1Q_ID", "")
+		}
+		itemBody, _ = sjson.Set(itemBody, "IP_MASK", item.Ipv4Address.ValueString())
+		itemBody, _ = sjson.Set(itemBody, "NEIGHBOR_IP", item.NeighborIpv4.ValueString())
+		itemBody, _ = sjson.Set(itemBody, "NEIGHBOR_ASN", item.NeighborAsn.ValueString())
+		itemBody, _ = sjson.Set(itemBody, "IPV6_MASK", item.Ipv6Address.ValueString())
+		itemBody, _ = sjson.Set(itemBody, "IPV6_NEIGHBOR", item.NeighborIpv6.ValueString())
+		itemBody, _ = sjson.Set(itemBody, "PEER_VRF_NAME", item.PeerVrfName.ValueString())
+		itemBody, _ = sjson.Set(itemBody, "AUTO_VRF_LITE_FLAG", fmt.Sprint(item.AutoVrfLite.ValueBool()))
+		itemBody, _ = sjson.Set(itemBody, "VRF_LITE_JYTHON_TEMPLATE", "Ext_VRF_Lite_Jython")
+		conns, _ = sjson.SetRaw(conns, "VRF_LITE_CONN.-1", itemBody)
+	}
+	body := ""
+	body, _ = sjson.Set(body, "VRF_LITE_CONN", conns)
+	body, _ = sjson.Set(body, "MULTISITE_CONN", `{"MULTISITE_CONN":[]}`)
+	return body
+}
+
+// vrfLiteFromExtensionValues returns the VRF Lite connections of the
+// extensionValues of an attachment, nil if there are none.
+func vrfLiteFromExtensionValues(extensionValues string) []VRFAttachmentsVrfLite {
+	conns := gjson.Get(extensionValues, "VRF_LITE_CONN")
+	if conns.Type == gjson.String {
+		conns = gjson.Parse(conns.String())
+	}
+	optional := func(v gjson.Result) types.String {
+		if v.String() == "" {
+			return types.StringNull()
+		}
+		return types.StringValue(v.String())
+	}
+	var items []VRFAttachmentsVrfLite
+	conns.Get("VRF_LITE_CONN").ForEach(func(_, v gjson.Result) bool {
+		item := VRFAttachmentsVrfLite{
+			InterfaceName: types.StringValue(v.Get("IF_NAME").String()),
+			Dot1qId:       types.Int64Null(),
+			Ipv4Address:   optional(v.Get("IP_MASK")),
+			NeighborIpv4:  optional(v.Get("NEIGHBOR_IP")),
+			NeighborAsn:   optional(v.Get("NEIGHBOR_ASN")),
+			Ipv6Address:   optional(v.Get("IPV6_MASK")),
+			NeighborIpv6:  optional(v.Get("IPV6_NEIGHBOR")),
+			PeerVrfName:   optional(v.Get("PEER_VRF_NAME")),
+			AutoVrfLite:   types.BoolValue(v.Get("AUTO_VRF_LITE_FLAG").Bool()),
+		}
+		if value := v.Get("DOT1Q_ID"); value.String() != "" {
+			item.Dot1qId = types.Int64Value(value.Int())
+		}
+		items = append(items, item)
 		return true
 	})
-	log.Printf("Akash  filled serialNumber %v", data.Attachments)
+	return items
 }
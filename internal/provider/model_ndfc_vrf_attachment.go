@@ -0,0 +1,173 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+type VRFAttachment struct {
+	Id              types.String            `tfsdk:"id"`
+	Timeouts        timeouts.Value          `tfsdk:"timeouts"`
+	FabricName      types.String            `tfsdk:"fabric_name"`
+	VrfName         types.String            `tfsdk:"vrf_name"`
+	SerialNumber    types.String            `tfsdk:"serial_number"`
+	VlanId          types.Int64             `tfsdk:"vlan_id"`
+	FreeformConfig  types.String            `tfsdk:"freeform_config"`
+	LoopbackId      types.Int64             `tfsdk:"loopback_id"`
+	LoopbackIpv4    types.String            `tfsdk:"loopback_ipv4"`
+	LoopbackIpv6    types.String            `tfsdk:"loopback_ipv6"`
+	ExtensionValues types.String            `tfsdk:"extension_values"`
+	VrfLite         []VRFAttachmentsVrfLite `tfsdk:"vrf_lite"`
+	Deploy          types.Bool              `tfsdk:"deploy"`
+	Status          types.String            `tfsdk:"status"`
+}
+
+func (data VRFAttachment) getId() string {
+	return data.FabricName.ValueString() + "/" + data.VrfName.ValueString() + "/" + data.SerialNumber.ValueString()
+}
+
+// equal reports whether data and other configure the same attachment,
+// ignoring the timeouts and the status.
+func (data VRFAttachment) equal(other VRFAttachment) bool {
+	return data.Id.Equal(other.Id) &&
+		data.FabricName.Equal(other.FabricName) &&
+		data.VrfName.Equal(other.VrfName) &&
+		data.SerialNumber.Equal(other.SerialNumber) &&
+		data.VlanId.Equal(other.VlanId) &&
+		data.FreeformConfig.Equal(other.FreeformConfig) &&
+		data.LoopbackId.Equal(other.LoopbackId) &&
+		data.LoopbackIpv4.Equal(other.LoopbackIpv4) &&
+		data.LoopbackIpv6.Equal(other.LoopbackIpv6) &&
+		data.ExtensionValues.Equal(other.ExtensionValues) &&
+		ndfcEqualVrfLite(data.VrfLite, other.VrfLite) &&
+		data.Deploy.Equal(other.Deploy)
+}
+
+func (data VRFAttachment) fieldPaths() map[string]path.Path {
+	return map[string]path.Path{
+		"vlan":            path.Root("vlan_id"),
+		"vlanId":          path.Root("vlan_id"),
+		"freeformConfig":  path.Root("freeform_config"),
+		"instanceValues":  path.Root("loopback_id"),
+		"extensionValues": path.Root("extension_values"),
+		"VRF_LITE_CONN":   path.Root("vrf_lite"),
+	}
+}
+
+// toBody returns the body attaching the VRF to the switch, or detaching it if
+// attach is false.
+func (data VRFAttachment) toBody(ctx context.Context, attach bool) string {
+	itemBody := ""
+	itemBody, _ = sjson.Set(itemBody, "fabric", data.FabricName.ValueString())
+	itemBody, _ = sjson.Set(itemBody, "vrfName", data.VrfName.ValueString())
+	itemBody, _ = sjson.Set(itemBody, "serialNumber", data.SerialNumber.ValueString())
+	if !data.VlanId.IsNull() && !data.VlanId.IsUnknown() {
+		itemBody, _ = sjson.Set(itemBody, "vlan", data.VlanId.ValueInt64())
+	}
+	if attach {
+		if !data.FreeformConfig.IsNull() && !data.FreeformConfig.IsUnknown() {
+			itemBody, _ = sjson.Set(itemBody, "freeformConfig", data.FreeformConfig.ValueString())
+		}
+		instanceBody := ""
+		if !data.LoopbackId.IsNull() && !data.LoopbackId.IsUnknown() {
+			instanceBody, _ = sjson.Set(instanceBody, "loopbackId", data.LoopbackId.ValueInt64())
+		}
+		if !data.LoopbackIpv4.IsNull() && !data.LoopbackIpv4.IsUnknown() {
+			instanceBody, _ = sjson.Set(instanceBody, "loopbackIpAddress", data.LoopbackIpv4.ValueString())
+		}
+		if !data.LoopbackIpv6.IsNull() && !data.LoopbackIpv6.IsUnknown() {
+			instanceBody, _ = sjson.Set(instanceBody, "loopbackIpV6Address", data.LoopbackIpv6.ValueString())
+		}
+		if instanceBody != "" {
+			itemBody, _ = sjson.Set(itemBody, "instanceValues", instanceBody)
+		}
+		if !data.ExtensionValues.IsNull() && !data.ExtensionValues.IsUnknown() {
+			itemBody, _ = sjson.Set(itemBody, "extensionValues", data.ExtensionValues.ValueString())
+		} else if data.VrfLite != nil {
+			itemBody, _ = sjson.Set(itemBody, "extensionValues", vrfLiteExtensionValues(data.VrfLite))
+		} else {
+			itemBody, _ = sjson.Set(itemBody, "extensionValues", "")
+		}
+	}
+	itemBody, _ = sjson.Set(itemBody, "deployment", attach)
+
+	body := ""
+	body, _ = sjson.Set(body, "0.vrfName", data.VrfName.ValueString())
+	body, _ = sjson.SetRaw(body, "0.lanAttachList.-1", itemBody)
+	return body
+}
+
+// fromBody updates data from res, the entry of the switch in the lanAttachList
+// of the VRF. vlan is the VLAN of the VRF, which is kept as -1.
+func (data *VRFAttachment) fromBody(ctx context.Context, res gjson.Result, vlan int64) {
+	if value := res.Get("vlanId"); value.Exists() && !(data.VlanId.ValueInt64() == -1 && value.Int() == vlan) {
+		data.VlanId = types.Int64Value(value.Int())
+	}
+	if value := res.Get("freeformConfig"); value.Exists() && value.String() != "" {
+		data.FreeformConfig = types.StringValue(value.String())
+	} else {
+		data.FreeformConfig = types.StringNull()
+	}
+	// NDFC returns the instance values as an embedded JSON string
+	instanceValues := res.Get("instanceValues")
+	if instanceValues.Type == gjson.String {
+		instanceValues = gjson.Parse(instanceValues.String())
+	}
+	if value := instanceValues.Get("loopbackId"); value.Exists() && value.String() != "" {
+		data.LoopbackId = types.Int64Value(value.Int())
+	} else {
+		data.LoopbackId = types.Int64Null()
+	}
+	if value := instanceValues.Get("loopbackIpAddress"); value.Exists() && value.String() != "" {
+		data.LoopbackIpv4 = types.StringValue(value.String())
+	} else {
+		data.LoopbackIpv4 = types.StringNull()
+	}
+	if value := instanceValues.Get("loopbackIpV6Address"); value.Exists() && value.String() != "" {
+		data.LoopbackIpv6 = types.StringValue(value.String())
+	} else {
+		data.LoopbackIpv6 = types.StringNull()
+	}
+	// extension values are read as JSON string if that is managed, else as
+	// VRF Lite connections if those are managed or imported. NDFC creates
+	// VRF Lite connections on border switches by itself.
+	if value := res.Get("extensionValues"); value.Exists() {
+		if data.ExtensionValues.IsNull() {
+			if data.VrfLite != nil {
+				data.VrfLite = vrfLiteFromExtensionValues(value.String())
+			}
+		} else if value.String() != "" {
+			data.ExtensionValues = types.StringValue(value.String())
+		} else {
+			data.ExtensionValues = types.StringNull()
+		}
+	}
+	if value := res.Get("lanAttachState"); value.Exists() {
+		data.Status = types.StringValue(value.String())
+	} else {
+		data.Status = types.StringValue(ndfc.StateNA)
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package ndfc
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tidwall/gjson"
+)
+
+var (
+	// BatchWindow is how long a batch stays open for other requests to join
+	// after its first request.
+	BatchWindow = time.Second
+	// MaxBatchSize is the number of requests after which a batch is sent
+	// without waiting for BatchWindow to pass.
+	MaxBatchSize = 100
+)
+
+// batch collects requests that are sent to NDFC as a single call. Each
+// request adds values under a key, e.g. object names under a serial number.
+type batch struct {
+	entries map[string]map[string]bool
+	size    int
+	done    chan struct{}
+	res     gjson.Result
+	err     error
+}
+
+// keys returns the keys of the batch, sorted.
+func (b *batch) keys() []string {
+	keys := make([]string, 0, len(b.entries))
+	for key := range b.entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// values returns the values of key, sorted and comma separated.
+func (b *batch) values(key string) string {
+	values := make([]string, 0, len(b.entries[key]))
+	for value := range b.entries[key] {
+		values = append(values, value)
+	}
+	sort.Strings(values)
+	return strings.Join(values, ",")
+}
+
+// batcher coalesces concurrent requests of the same kind, identified by an
+// id, into batches.
+type batcher struct {
+	mu   sync.Mutex
+	open map[string]*batch
+}
+
+// do adds entries to the open batch for id, opening one if needed, and waits
+// for the batch to be sent by send. It returns the result of send and the
+// number of requests in the batch. The batch is sent regardless of ctx, a
+// request whose ctx is done merely stops waiting for it.
+func (b *batcher) do(ctx context.Context, id string, entries map[string][]string, send func(*batch) (gjson.Result, error)) (gjson.Result, int, error) {
+	b.mu.Lock()
+	if b.open == nil {
+		b.open = make(map[string]*batch)
+	}
+	bt := b.open[id]
+	if bt == nil {
+		bt = &batch{entries: make(map[string]map[string]bool), done: make(chan struct{})}
+		b.open[id] = bt
+		time.AfterFunc(BatchWindow, func() { b.flush(id, bt, send) })
+	}
+	for key, values := range entries {
+		if bt.entries[key] == nil {
+			bt.entries[key] = make(map[string]bool)
+		}
+		for _, value := range values {
+			bt.entries[key][value] = true
+		}
+	}
+	bt.size++
+	size := bt.size
+	full := size >= MaxBatchSize
+	b.mu.Unlock()
+
+	if full {
+		go b.flush(id, bt, send)
+	}
+	select {
+	case <-ctx.Done():
+		return gjson.Result{}, size, ctx.Err()
+	case <-bt.done:
+		b.mu.Lock()
+		size = bt.size
+		b.mu.Unlock()
+		return bt.res, size, bt.err
+	}
+}
+
+// flush closes the batch and sends it, unless that already happened.
+func (b *batcher) flush(id string, bt *batch, send func(*batch) (gjson.Result, error)) {
+	b.mu.Lock()
+	if b.open[id] != bt {
+		b.mu.Unlock()
+		return
+	}
+	delete(b.open, id)
+	b.mu.Unlock()
+
+	bt.res, bt.err = send(bt)
+	close(bt.done)
+}
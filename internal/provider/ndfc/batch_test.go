@@ -0,0 +1,109 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package ndfc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tidwall/gjson"
+)
+
+func TestBatcher(t *testing.T) {
+	defer func(d time.Duration) { BatchWindow = d }(BatchWindow)
+	BatchWindow = 50 * time.Millisecond
+
+	var b batcher
+	var sends int32
+	var body string
+	send := func(bt *batch) (gjson.Result, error) {
+		atomic.AddInt32(&sends, 1)
+		for _, key := range bt.keys() {
+			body += key + "=" + bt.values(key) + ";"
+		}
+		return gjson.Parse(`"ok"`), nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			res, size, err := b.do(context.Background(), "deploy", map[string][]string{"S1": {fmt.Sprintf("N%d", i)}, "S2": {"N0"}}, send)
+			if err != nil || res.String() != "ok" || size != 5 {
+				t.Errorf("got %v, %d, %v, want ok, 5, nil", res, size, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+	if sends != 1 {
+		t.Errorf("sends = %d, want 1", sends)
+	}
+	if want := "S1=N0,N1,N2,N3,N4;S2=N0;"; body != want {
+		t.Errorf("body = %q, want %q", body, want)
+	}
+}
+
+func TestBatcherFull(t *testing.T) {
+	defer func(d time.Duration, n int) { BatchWindow, MaxBatchSize = d, n }(BatchWindow, MaxBatchSize)
+	BatchWindow = time.Hour
+	MaxBatchSize = 1
+
+	var b batcher
+	_, size, err := b.do(context.Background(), "deploy", map[string][]string{"S1": {"N1"}}, func(*batch) (gjson.Result, error) {
+		return gjson.Result{}, nil
+	})
+	if err != nil || size != 1 {
+		t.Errorf("got %d, %v, want 1, nil", size, err)
+	}
+}
+
+func TestDeployConcurrent(t *testing.T) {
+	defer func(p, w time.Duration) { PollInterval, BatchWindow = p, w }(PollInterval, BatchWindow)
+	PollInterval = time.Millisecond
+	BatchWindow = 10 * time.Millisecond
+
+	client := newMockClient(t)
+	ctx := context.Background()
+	if _, err := client.Post(ctx, VRFType.Path("CML"), `{"fabric":"CML","vrfName":"VRF1"}`); err != nil {
+		t.Fatalf("create vrf: %v", err)
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		name := fmt.Sprintf("NET%d", i)
+		if _, err := client.Post(ctx, NetworkType.Path("CML"), `{"fabric":"CML","networkName":"`+name+`","vrf":"VRF1"}`); err != nil {
+			t.Fatalf("create network: %v", err)
+		}
+		attach := `[{"networkName":"` + name + `","lanAttachList":[{"fabric":"CML","networkName":"` + name + `","serialNumber":"9DBYO6WQJ46","vlan":-1,"deployment":true}]}]`
+		if _, err := client.Post(ctx, NetworkType.Path("CML")+"attachments", attach); err != nil {
+			t.Fatalf("attach network: %v", err)
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := client.Deploy(ctx, NetworkType, "CML", name); err != nil {
+				t.Errorf("deploy %v: %v", name, err)
+			}
+		}()
+	}
+	wg.Wait()
+}
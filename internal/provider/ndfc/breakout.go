@@ -0,0 +1,81 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package ndfc
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tidwall/sjson"
+)
+
+const BreakoutPath = InterfacePath + "/breakout"
+
+var breakoutMapRe = regexp.MustCompile(`^\d+g-(\d)x$`)
+
+// BreakoutChildren returns the names of the interfaces a physical interface
+// is split into by a breakout map, e.g. "25g-4x" splits Ethernet1/49 into
+// Ethernet1/49/1 to Ethernet1/49/4.
+func BreakoutChildren(ifName, breakoutMap string) ([]string, error) {
+	m := breakoutMapRe.FindStringSubmatch(breakoutMap)
+	if m == nil {
+		return nil, fmt.Errorf("invalid breakout map %q", breakoutMap)
+	}
+	count, _ := strconv.Atoi(m[1])
+	children := make([]string, count)
+	for i := range children {
+		children[i] = fmt.Sprintf("%s/%d", ifName, i+1)
+	}
+	return children, nil
+}
+
+// BreakoutInterface splits a physical interface according to breakoutMap.
+// The child interfaces only appear once the switch is deployed.
+func (c *Client) BreakoutInterface(ctx context.Context, ref InterfaceRef, breakoutMap string) error {
+	body := interfaceRefsBody([]InterfaceRef{ref})
+	body, _ = sjson.Set(body, "0.map", breakoutMap)
+	_, err := c.Post(ctx, BreakoutPath, body)
+	return err
+}
+
+// UnbreakoutInterface merges the child interfaces of a broken out interface
+// back into the physical interface.
+func (c *Client) UnbreakoutInterface(ctx context.Context, ref InterfaceRef) error {
+	_, err := c.Delete(ctx, BreakoutPath, interfaceRefsBody([]InterfaceRef{ref}))
+	return err
+}
+
+// WaitForInterfaces blocks until all named interfaces exist on the switch.
+func (c *Client) WaitForInterfaces(ctx context.Context, serialNumber string, ifNames ...string) error {
+	return Poll(ctx, func(ctx context.Context) (bool, error) {
+		for _, ifName := range ifNames {
+			_, err := c.GetInterface(ctx, InterfaceRef{SerialNumber: serialNumber, IfName: ifName})
+			if IsNotFound(err) {
+				tflog.Debug(ctx, fmt.Sprintf("%v: waiting for interface %v", serialNumber, ifName))
+				return false, nil
+			}
+			if err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	})
+}
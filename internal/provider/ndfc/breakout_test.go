@@ -0,0 +1,78 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package ndfc
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBreakoutChildren(t *testing.T) {
+	got, err := BreakoutChildren("Ethernet1/49", "25g-4x")
+	if err != nil {
+		t.Fatalf("breakout children: %v", err)
+	}
+	want := []string{"Ethernet1/49/1", "Ethernet1/49/2", "Ethernet1/49/3", "Ethernet1/49/4"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("children = %v, want %v", got, want)
+	}
+	if _, err := BreakoutChildren("Ethernet1/49", "4x25g"); err == nil {
+		t.Error("expected error for invalid breakout map")
+	}
+}
+
+func TestBreakoutInterface(t *testing.T) {
+	defer func(p time.Duration) { PollInterval = p }(PollInterval)
+	PollInterval = time.Millisecond
+
+	client := newMockClient(t)
+	ctx := context.Background()
+	parent := InterfaceRef{SerialNumber: "9DBYO6WQJ46", IfName: "Ethernet1/49"}
+
+	if err := client.BreakoutInterface(ctx, InterfaceRef{SerialNumber: "9DBYO6WQJ46", IfName: "Ethernet1/49/1"}, "25g-4x"); !IsValidation(err) {
+		t.Fatalf("breakout child interface: got %v, want validation error", err)
+	}
+	if err := client.BreakoutInterface(ctx, parent, "25g-4x"); err != nil {
+		t.Fatalf("breakout: %v", err)
+	}
+	children, _ := BreakoutChildren(parent.IfName, "25g-4x")
+	if _, err := client.GetInterface(ctx, InterfaceRef{SerialNumber: parent.SerialNumber, IfName: children[0]}); !IsNotFound(err) {
+		t.Fatalf("get child before deploy: got %v, want not found", err)
+	}
+	if err := client.DeploySwitches(ctx, "CML", parent.SerialNumber); err != nil {
+		t.Fatalf("deploy: %v", err)
+	}
+	if err := client.WaitForInterfaces(ctx, parent.SerialNumber, children...); err != nil {
+		t.Fatalf("wait for children: %v", err)
+	}
+	if _, err := client.GetInterface(ctx, parent); !IsNotFound(err) {
+		t.Fatalf("get broken out parent: got %v, want not found", err)
+	}
+
+	if err := client.UnbreakoutInterface(ctx, parent); err != nil {
+		t.Fatalf("unbreakout: %v", err)
+	}
+	if err := client.DeploySwitches(ctx, "CML", parent.SerialNumber); err != nil {
+		t.Fatalf("deploy: %v", err)
+	}
+	if err := client.WaitForInterfaces(ctx, parent.SerialNumber, parent.IfName); err != nil {
+		t.Fatalf("wait for parent: %v", err)
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Package ndfc is the NDFC API client shared by all resources and data
+// sources. It wraps go-nd and adds context cancellation, error
+// classification, bounded retries of transient failures, serialization of
+// mutating requests and batching of concurrent deployments.
+package ndfc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netascode/go-nd"
+	"github.com/tidwall/gjson"
+)
+
+const (
+	BasePath = "/appcenter/cisco/ndfc/api/v1"
+
+	DefaultMaxRetries = 3
+	DefaultRetryDelay = 2 * time.Second
+	maxRetryDelay     = 60 * time.Second
+)
+
+// Client is an NDFC API client. It is safe for concurrent use.
+type Client struct {
+	nd *nd.Client
+
+	// MaxRetries is the number of times a retryable failure is retried, see
+	// Do.
+	MaxRetries int
+	// RetryDelay is the delay before the first retry. It doubles with every
+	// further attempt.
+	RetryDelay time.Duration
+
+	// mu serializes POST, PUT and DELETE requests, as NDFC does not cope well
+	// with concurrent changes.
+	mu sync.Mutex
+	// batches coalesces concurrent deployments.
+	batches batcher
+}
+
+// NewClient returns a client for the NDFC instance at url. Requests failing
+// with a retryable error are retried up to retries times.
+func NewClient(url, username, password, domain string, insecure bool, retries int) (*Client, error) {
+	// Retries are handled by Client so that they can be cancelled.
+	c, err := nd.NewClient(url, BasePath, username, password, domain, insecure, nd.MaxRetries(0))
+	if err != nil {
+		return nil, err
+	}
+	return &Client{nd: &c, MaxRetries: retries, RetryDelay: DefaultRetryDelay}, nil
+}
+
+// Get sends a GET request to path, relative to BasePath.
+func (c *Client) Get(ctx context.Context, path string) (gjson.Result, error) {
+	return c.Do(ctx, http.MethodGet, path, "")
+}
+
+// Post sends a POST request with body to path.
+func (c *Client) Post(ctx context.Context, path, body string) (gjson.Result, error) {
+	return c.Do(ctx, http.MethodPost, path, body)
+}
+
+// Put sends a PUT request with body to path.
+func (c *Client) Put(ctx context.Context, path, body string) (gjson.Result, error) {
+	return c.Do(ctx, http.MethodPut, path, body)
+}
+
+// Delete sends a DELETE request with an optional body to path.
+func (c *Client) Delete(ctx context.Context, path, body string) (gjson.Result, error) {
+	return c.Do(ctx, http.MethodDelete, path, body)
+}
+
+// Do sends a request and returns the parsed response. Failures are returned
+// as *Error, retryable ones after MaxRetries retries. GET requests are
+// retried on any transient error, mutating requests only if the connection
+// could not be established or on 502, 503 or 504 responses. The response is
+// returned alongside the error, as NDFC often reports details in its body.
+func (c *Client) Do(ctx context.Context, method, path, body string) (gjson.Result, error) {
+	reauthenticated := false
+	for attempt := 0; ; attempt++ {
+		res, err := c.sendSerialized(ctx, method, path, body)
+		if err == nil {
+			return res, nil
+		}
+		e := newError(method, path, res, err)
+		if ctx.Err() != nil {
+			e.Kind, e.Err = KindUnknown, ctx.Err()
+			return res, e
+		}
+		if e.StatusCode == http.StatusUnauthorized && !reauthenticated {
+			// The token has expired, log in again once.
+			tflog.Debug(ctx, fmt.Sprintf("%s %s: token expired, logging in again", method, path))
+			c.resetToken()
+			reauthenticated = true
+			attempt--
+			continue
+		}
+		if !e.retryable() || attempt >= c.MaxRetries {
+			return res, e
+		}
+		delay := c.backoff(attempt)
+		tflog.Debug(ctx, fmt.Sprintf("%s %s: %s, retrying in %v (%d/%d)", method, path, e, delay, attempt+1, c.MaxRetries))
+		select {
+		case <-ctx.Done():
+			e.Kind, e.Err = KindUnknown, ctx.Err()
+			return res, e
+		case <-time.After(delay):
+		}
+	}
+}
+
+// sendSerialized sends the request, holding mu for mutating requests. mu is
+// released between retries so that other requests are not blocked by the
+// backoff.
+func (c *Client) sendSerialized(ctx context.Context, method, path, body string) (gjson.Result, error) {
+	if method != http.MethodGet {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+	}
+	return c.send(ctx, method, path, body)
+}
+
+func (c *Client) send(ctx context.Context, method, path, body string) (gjson.Result, error) {
+	if err := c.nd.Authenticate(); err != nil {
+		return gjson.Result{}, err
+	}
+	var r io.Reader
+	if body != "" {
+		r = strings.NewReader(body)
+	}
+	req := c.nd.NewReq(method, c.nd.BasePath+path, r, func(req *nd.Req) {
+		req.HttpReq = req.HttpReq.WithContext(ctx)
+	})
+	return c.nd.Do(req)
+}
+
+func (c *Client) resetToken() {
+	c.nd.AuthenticationMutex.Lock()
+	c.nd.Token = ""
+	c.nd.AuthenticationMutex.Unlock()
+}
+
+func (c *Client) backoff(attempt int) time.Duration {
+	if c.RetryDelay <= 0 {
+		return 0
+	}
+	delay := c.RetryDelay << attempt
+	if delay > maxRetryDelay || delay < c.RetryDelay {
+		return maxRetryDelay
+	}
+	return delay
+}
@@ -0,0 +1,270 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package ndfc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfcmock"
+	"github.com/tidwall/gjson"
+)
+
+func newMockClient(t *testing.T) *Client {
+	t.Helper()
+	server := ndfcmock.NewServer()
+	t.Cleanup(server.Close)
+	client, err := NewClient(server.URL, ndfcmock.DefaultUsername, ndfcmock.DefaultPassword, "", true, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+// newFlakyServer returns a server that logs in successfully and answers
+// all other requests with status.
+func newFlakyServer(t *testing.T, status int, calls *int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/login" {
+			_, _ = w.Write([]byte(`{"token":"x"}`))
+			return
+		}
+		atomic.AddInt32(calls, 1)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"message":"try again later"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestErrorKinds(t *testing.T) {
+	client := newMockClient(t)
+	ctx := context.Background()
+	vrfs := "/lan-fabric/rest/top-down/v2/fabrics/CML/vrfs/"
+
+	_, err := client.Get(ctx, vrfs+"MISSING")
+	if !IsNotFound(err) {
+		t.Errorf("get missing vrf: got %v, want not found", err)
+	}
+
+	if _, err := client.Post(ctx, vrfs, `{"fabric":"CML","vrfName":"VRF1"}`); err != nil {
+		t.Fatalf("create vrf: %v", err)
+	}
+	_, err = client.Post(ctx, vrfs, `{"fabric":"CML","vrfName":"VRF1"}`)
+	if !IsConflict(err) {
+		t.Errorf("create duplicate vrf: got %v, want conflict", err)
+	}
+
+	_, err = client.Post(ctx, vrfs, `{"fabric":"CML"}`)
+	if !IsValidation(err) {
+		t.Errorf("create vrf without name: got %v, want validation", err)
+	}
+	var e *Error
+	if !errors.As(err, &e) || e.StatusCode != http.StatusBadRequest || e.Message != "vrfName is mandatory" {
+		t.Errorf("unexpected error details: %#v", err)
+	}
+}
+
+func TestDeployInterfaces(t *testing.T) {
+	client := newMockClient(t)
+	ctx := context.Background()
+
+	if _, err := client.DeployInterfaces(ctx, InterfaceRef{SerialNumber: "9DBYO6WQJ46", IfName: "Ethernet1/10"}); err != nil {
+		t.Fatalf("deploy interface: %v", err)
+	}
+	if _, err := client.DeployInterfaces(ctx, InterfaceRef{SerialNumber: "UNKNOWN", IfName: "Ethernet1/10"}); !IsNotFound(err) {
+		t.Errorf("deploy interface on unknown switch: got %v, want not found", err)
+	}
+}
+
+func TestTransientRetries(t *testing.T) {
+	var calls int32
+	server := newFlakyServer(t, http.StatusServiceUnavailable, &calls)
+	client, _ := NewClient(server.URL, "admin", "admin", "", true, 2)
+	client.RetryDelay = time.Millisecond
+
+	_, err := client.Get(context.Background(), "/x")
+	if !IsTransient(err) {
+		t.Errorf("got %v, want transient", err)
+	}
+	if calls != 3 {
+		t.Errorf("calls = %d, want 3", calls)
+	}
+}
+
+func TestRetryMutating(t *testing.T) {
+	for _, tc := range []struct {
+		status int
+		calls  int32
+	}{
+		{status: http.StatusInternalServerError, calls: 1},
+		{status: http.StatusBadGateway, calls: 3},
+		{status: http.StatusServiceUnavailable, calls: 3},
+		{status: http.StatusGatewayTimeout, calls: 3},
+	} {
+		var calls int32
+		server := newFlakyServer(t, tc.status, &calls)
+		client, _ := NewClient(server.URL, "admin", "admin", "", true, 2)
+		client.RetryDelay = time.Millisecond
+
+		if _, err := client.Post(context.Background(), "/x", "{}"); !IsTransient(err) {
+			t.Errorf("status %d: got %v, want transient", tc.status, err)
+		}
+		if calls != tc.calls {
+			t.Errorf("status %d: calls = %d, want %d", tc.status, calls, tc.calls)
+		}
+	}
+
+	var calls int32
+	server := newFlakyServer(t, http.StatusInternalServerError, &calls)
+	client, _ := NewClient(server.URL, "admin", "admin", "", true, 2)
+	client.RetryDelay = time.Millisecond
+	if _, err := client.Get(context.Background(), "/x"); !IsTransient(err) {
+		t.Errorf("get: got %v, want transient", err)
+	}
+	if calls != 3 {
+		t.Errorf("get: calls = %d, want 3", calls)
+	}
+}
+
+func TestNoRetryAfterSent(t *testing.T) {
+	var calls int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/login" {
+			_, _ = w.Write([]byte(`{"token":"x"}`))
+			return
+		}
+		_, _ = io.ReadAll(r.Body)
+		atomic.AddInt32(&calls, 1)
+		<-release
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(release) })
+	client, _ := NewClient(server.URL, "admin", "admin", "", true, 2)
+	client.RetryDelay = time.Millisecond
+	client.nd.HttpClient.Timeout = 50 * time.Millisecond
+
+	if _, err := client.Post(context.Background(), "/x", "{}"); !IsTransient(err) {
+		t.Errorf("got %v, want transient", err)
+	}
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1", calls)
+	}
+}
+
+func TestRetryNotSent(t *testing.T) {
+	e := newError(http.MethodPost, "/x", gjson.Result{}, &url.Error{Op: "Post", URL: "https://ndfc/x", Err: &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}})
+	if !e.retryable() {
+		t.Errorf("connection refused: got not retryable")
+	}
+	e = newError(http.MethodPost, "/x", gjson.Result{}, &url.Error{Op: "Post", URL: "https://ndfc/x", Err: &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}})
+	if e.retryable() {
+		t.Errorf("connection reset: got retryable")
+	}
+}
+
+func TestRetryReleasesLock(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/login":
+			_, _ = w.Write([]byte(`{"token":"x"}`))
+		case BasePath + "/flaky":
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+	client, _ := NewClient(server.URL, "admin", "admin", "", true, 5)
+	client.RetryDelay = time.Hour
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.Post(ctx, "/flaky", "{}")
+		done <- err
+	}()
+	for atomic.LoadInt32(&calls) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// The first request is waiting to be retried, the second one must not
+	// be blocked by it.
+	other := make(chan error, 1)
+	go func() {
+		_, err := client.Post(context.Background(), "/other", "{}")
+		other <- err
+	}()
+	select {
+	case err := <-other:
+		if err != nil {
+			t.Errorf("post during backoff: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("post blocked by the backoff of another request")
+	}
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want canceled", err)
+	}
+}
+
+func TestNoRetryOnValidation(t *testing.T) {
+	var calls int32
+	server := newFlakyServer(t, http.StatusBadRequest, &calls)
+	client, _ := NewClient(server.URL, "admin", "admin", "", true, 2)
+	client.RetryDelay = time.Millisecond
+
+	if _, err := client.Post(context.Background(), "/x", "{}"); !IsValidation(err) {
+		t.Errorf("got %v, want validation", err)
+	}
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1", calls)
+	}
+}
+
+func TestRetryCancelled(t *testing.T) {
+	var calls int32
+	server := newFlakyServer(t, http.StatusServiceUnavailable, &calls)
+	client, _ := NewClient(server.URL, "admin", "admin", "", true, 5)
+	client.RetryDelay = time.Hour
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := client.Get(ctx, "/x")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got %v, want deadline exceeded", err)
+	}
+	if calls != 1 {
+		t.Errorf("calls = %d, want 1", calls)
+	}
+}
@@ -0,0 +1,274 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package ndfc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// Attachment states as reported in lanAttachState.
+const (
+	StateNA         = "NA"
+	StatePending    = "PENDING"
+	StateInProgress = "IN PROGRESS"
+	StateDeployed   = "DEPLOYED"
+	StateOutOfSync  = "OUT-OF-SYNC"
+	StateFailed     = "FAILED"
+)
+
+// maxDeployAttempts is the number of times a switch is deployed before its
+// attachment is reported as failed.
+const maxDeployAttempts = 3
+
+// ObjectType describes a top-down object type whose attachments to switches
+// are deployed.
+type ObjectType struct {
+	// Name is used in messages.
+	Name string
+	// Collection is the path segment of the type in the top-down API.
+	Collection string
+	// NamesQuery is the query parameter selecting attachments by object name.
+	NamesQuery string
+	// NameField is the field holding the object name in responses.
+	NameField string
+}
+
+var (
+	VRFType     = ObjectType{Name: "VRF", Collection: "vrfs", NamesQuery: "vrf-names", NameField: "vrfName"}
+	NetworkType = ObjectType{Name: "network", Collection: "networks", NamesQuery: "network-names", NameField: "networkName"}
+)
+
+// Path returns the path of the objects of the type in fabric.
+func (t ObjectType) Path(fabric string) string {
+	return fmt.Sprintf("/lan-fabric/rest/top-down/v2/fabrics/%v/%v/", url.QueryEscape(fabric), t.Collection)
+}
+
+// GetAttachments returns the attachments of the named object to all switches
+// of the fabric, as a list with a single entry holding the lanAttachList.
+func (c *Client) GetAttachments(ctx context.Context, t ObjectType, fabric, name string) (gjson.Result, error) {
+	return c.Get(ctx, fmt.Sprintf("%vattachments?%v=%v", t.Path(fabric), t.NamesQuery, url.QueryEscape(name)))
+}
+
+// GetAttachment returns the attachment of the named object to the switch with
+// serial number serial, the entry of the switch in the lanAttachList.
+func (c *Client) GetAttachment(ctx context.Context, t ObjectType, fabric, name, serial string) (gjson.Result, error) {
+	res, err := c.GetAttachments(ctx, t, fabric, name)
+	if err != nil {
+		return res, err
+	}
+	entry := res.Get(fmt.Sprintf(`#(%v==%q).lanAttachList.#(switchSerialNo==%q)`, t.NameField, name, serial))
+	if !entry.Exists() {
+		return res, &Error{
+			Kind:     KindNotFound,
+			Method:   http.MethodGet,
+			Path:     t.Path(fabric) + "attachments",
+			Message:  fmt.Sprintf("switch %v is not part of the fabric of %v %v", serial, t.Name, name),
+			Response: res,
+		}
+	}
+	return entry, nil
+}
+
+// DeployError is returned by Deploy if some switches did not reach their
+// expected state.
+type DeployError struct {
+	Type ObjectType
+	Name string
+	// States maps the serial numbers of the failed switches to their last
+	// state.
+	States map[string]string
+}
+
+func (e *DeployError) Error() string {
+	serials := make([]string, 0, len(e.States))
+	for serial := range e.States {
+		serials = append(serials, serial)
+	}
+	sort.Strings(serials)
+	failed := make([]string, len(serials))
+	for i, serial := range serials {
+		state := e.States[serial]
+		if state == "" {
+			state = "not in fabric"
+		}
+		failed[i] = fmt.Sprintf("%v (%v)", serial, state)
+	}
+	return fmt.Sprintf("%v %v failed to deploy on %v", e.Type.Name, e.Name, strings.Join(failed, ", "))
+}
+
+// switchAttachment is the deployment state of an object on a switch.
+type switchAttachment struct {
+	attached bool
+	state    string
+}
+
+func (a switchAttachment) expected() string {
+	if a.attached {
+		return StateDeployed
+	}
+	return StateNA
+}
+
+// Deploy deploys the attachments of the named object on the given switches,
+// or on all switches of the fabric if none are given, and waits until every
+// attachment reached its expected state: DEPLOYED if attached and NA if not.
+//
+// Attachments IN PROGRESS are waited for, PENDING and OUT-OF-SYNC ones are
+// deployed up to maxDeployAttempts times. FAILED attachments are deployed
+// once. Switches that do not reach their expected state are reported with a
+// DeployError. Deploy waits until ctx is done.
+func (c *Client) Deploy(ctx context.Context, t ObjectType, fabric, name string, serials ...string) error {
+	targets := make(map[string]bool, len(serials))
+	for _, serial := range serials {
+		targets[serial] = true
+	}
+	attempts := map[string]int{}
+	current := map[string]switchAttachment{}
+
+	err := Poll(ctx, func(ctx context.Context) (bool, error) {
+		res, err := c.getAttachmentsBatched(ctx, t, fabric, name)
+		if err != nil {
+			return false, err
+		}
+		res.Get("lanAttachList").ForEach(func(_, v gjson.Result) bool {
+			serial := v.Get("switchSerialNo").String()
+			if len(targets) == 0 || targets[serial] {
+				current[serial] = switchAttachment{attached: v.Get("isLanAttached").Bool(), state: v.Get("lanAttachState").String()}
+			}
+			return true
+		})
+
+		settled := true
+		var deploy []string
+		for serial, a := range current {
+			switch {
+			case a.state == a.expected():
+			case a.state == StateInProgress:
+				settled = false
+			case a.state == StateFailed && attempts[serial] > 0:
+			case attempts[serial] < maxDeployAttempts:
+				deploy = append(deploy, serial)
+				settled = false
+			}
+		}
+		if len(deploy) == 0 {
+			return settled, nil
+		}
+		sort.Strings(deploy)
+		tflog.Debug(ctx, fmt.Sprintf("%v %v: deploying on %v", t.Name, name, strings.Join(deploy, ", ")))
+		for _, serial := range deploy {
+			attempts[serial]++
+		}
+		return false, c.deploySwitches(ctx, t, name, deploy)
+	})
+	if err != nil {
+		return err
+	}
+
+	failed := map[string]string{}
+	for serial := range targets {
+		if _, ok := current[serial]; !ok {
+			failed[serial] = ""
+		}
+	}
+	for serial, a := range current {
+		if a.state != a.expected() {
+			failed[serial] = a.state
+		}
+	}
+	if len(failed) > 0 {
+		return &DeployError{Type: t, Name: name, States: failed}
+	}
+	return nil
+}
+
+// WaitDeployments waits until no attachment of the named object is IN
+// PROGRESS, without deploying anything. It waits until ctx is done.
+func (c *Client) WaitDeployments(ctx context.Context, t ObjectType, fabric, name string) error {
+	return Poll(ctx, func(ctx context.Context) (bool, error) {
+		res, err := c.getAttachmentsBatched(ctx, t, fabric, name)
+		if err != nil {
+			return false, err
+		}
+		return !res.Get(fmt.Sprintf(`lanAttachList.#(lanAttachState==%q)`, StateInProgress)).Exists(), nil
+	})
+}
+
+// getAttachmentsBatched returns the attachments of the named object, the
+// entry of GetAttachments. Concurrent requests for objects of the same type
+// and fabric are sent as one request.
+func (c *Client) getAttachmentsBatched(ctx context.Context, t ObjectType, fabric, name string) (gjson.Result, error) {
+	id := "attachments " + t.Collection + " " + fabric
+	res, size, err := c.batches.do(ctx, id, map[string][]string{"": {name}}, func(b *batch) (gjson.Result, error) {
+		return c.GetAttachments(context.Background(), t, fabric, b.values(""))
+	})
+	if err != nil && size > 1 && ctx.Err() == nil {
+		// do not let one failing object fail the whole batch
+		res, err = c.GetAttachments(ctx, t, fabric, name)
+	}
+	if err != nil {
+		return res, err
+	}
+	entry := res.Get(fmt.Sprintf(`#(%v==%q)`, t.NameField, name))
+	if !entry.Exists() {
+		return res, &Error{
+			Kind:     KindNotFound,
+			Method:   http.MethodGet,
+			Path:     t.Path(fabric) + "attachments",
+			Message:  fmt.Sprintf("no attachments returned for %v %v", t.Name, name),
+			Response: res,
+		}
+	}
+	return entry, nil
+}
+
+// deploySwitches deploys the named object on the given switches. Concurrent
+// deployments of objects of the same type are sent as one request, mapping
+// each switch to all objects to deploy on it.
+func (c *Client) deploySwitches(ctx context.Context, t ObjectType, name string, serials []string) error {
+	path := "/lan-fabric/rest/top-down/" + t.Collection + "/deploy"
+	entries := make(map[string][]string, len(serials))
+	for _, serial := range serials {
+		entries[serial] = []string{name}
+	}
+	_, size, err := c.batches.do(ctx, "deploy "+t.Collection, entries, func(b *batch) (gjson.Result, error) {
+		body := "{}"
+		for _, serial := range b.keys() {
+			body, _ = sjson.Set(body, serial, b.values(serial))
+		}
+		return c.Post(context.Background(), path, body)
+	})
+	if err != nil && size > 1 && ctx.Err() == nil {
+		// do not let one failing object fail the whole batch
+		body := "{}"
+		for _, serial := range serials {
+			body, _ = sjson.Set(body, serial, name)
+		}
+		_, err = c.Post(ctx, path, body)
+	}
+	return err
+}
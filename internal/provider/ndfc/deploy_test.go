@@ -0,0 +1,175 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package ndfc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDeploy(t *testing.T) {
+	defer func(p, w time.Duration) { PollInterval, BatchWindow = p, w }(PollInterval, BatchWindow)
+	PollInterval = time.Millisecond
+	BatchWindow = time.Millisecond
+
+	client := newMockClient(t)
+	ctx := context.Background()
+	state := func(serial string) string {
+		res, err := client.GetAttachments(ctx, NetworkType, "CML", "NET1")
+		if err != nil {
+			t.Fatalf("get attachments: %v", err)
+		}
+		return res.Get(`0.lanAttachList.#(switchSerialNo="` + serial + `").lanAttachState`).String()
+	}
+
+	if _, err := client.Post(ctx, VRFType.Path("CML"), `{"fabric":"CML","vrfName":"VRF1"}`); err != nil {
+		t.Fatalf("create vrf: %v", err)
+	}
+	if _, err := client.Post(ctx, NetworkType.Path("CML"), `{"fabric":"CML","networkName":"NET1","vrf":"VRF1"}`); err != nil {
+		t.Fatalf("create network: %v", err)
+	}
+	attach := `[{"networkName":"NET1","lanAttachList":[{"fabric":"CML","networkName":"NET1","serialNumber":"9DBYO6WQJ46","vlan":-1,"deployment":true},{"fabric":"CML","networkName":"NET1","serialNumber":"9RB5Y9BFNTU","vlan":-1,"deployment":true}]}]`
+	if _, err := client.Post(ctx, NetworkType.Path("CML")+"attachments", attach); err != nil {
+		t.Fatalf("attach network: %v", err)
+	}
+
+	if err := client.Deploy(ctx, NetworkType, "CML", "NET1", "9DBYO6WQJ46"); err != nil {
+		t.Fatalf("deploy on LEAF1: %v", err)
+	}
+	if got := state("9DBYO6WQJ46"); got != StateDeployed {
+		t.Errorf("LEAF1 state = %q, want %q", got, StateDeployed)
+	}
+	if got := state("9RB5Y9BFNTU"); got != StatePending {
+		t.Errorf("LEAF2 state = %q, want %q", got, StatePending)
+	}
+
+	if err := client.Deploy(ctx, NetworkType, "CML", "NET1"); err != nil {
+		t.Fatalf("deploy on all switches: %v", err)
+	}
+	if got := state("9RB5Y9BFNTU"); got != StateDeployed {
+		t.Errorf("LEAF2 state = %q, want %q", got, StateDeployed)
+	}
+
+	detach := `[{"networkName":"NET1","lanAttachList":[{"fabric":"CML","networkName":"NET1","serialNumber":"9DBYO6WQJ46","deployment":false}]}]`
+	if _, err := client.Post(ctx, NetworkType.Path("CML")+"attachments", detach); err != nil {
+		t.Fatalf("detach network: %v", err)
+	}
+	if err := client.Deploy(ctx, NetworkType, "CML", "NET1", "9DBYO6WQJ46"); err != nil {
+		t.Fatalf("undeploy on LEAF1: %v", err)
+	}
+	if got := state("9DBYO6WQJ46"); got != StateNA {
+		t.Errorf("LEAF1 state = %q, want %q", got, StateNA)
+	}
+}
+
+func TestDeployFailure(t *testing.T) {
+	defer func(p, w time.Duration) { PollInterval, BatchWindow = p, w }(PollInterval, BatchWindow)
+	PollInterval = time.Millisecond
+	BatchWindow = time.Millisecond
+
+	client := newMockClient(t)
+	ctx := context.Background()
+	if _, err := client.Post(ctx, VRFType.Path("CML"), `{"fabric":"CML","vrfName":"VRF1"}`); err != nil {
+		t.Fatalf("create vrf: %v", err)
+	}
+
+	err := client.Deploy(ctx, VRFType, "CML", "VRF1", "UNKNOWN")
+	var e *DeployError
+	if !errors.As(err, &e) {
+		t.Fatalf("got %v, want deploy error", err)
+	}
+	if state, ok := e.States["UNKNOWN"]; !ok || state != "" {
+		t.Errorf("States = %v, want UNKNOWN without state", e.States)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := client.Deploy(ctx, VRFType, "CML", "VRF1"); !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want context canceled", err)
+	}
+}
+
+func TestWaitDeployments(t *testing.T) {
+	defer func(p, w time.Duration) { PollInterval, BatchWindow = p, w }(PollInterval, BatchWindow)
+	PollInterval = time.Millisecond
+	BatchWindow = time.Millisecond
+
+	client := newMockClient(t)
+	ctx := context.Background()
+	if _, err := client.Post(ctx, VRFType.Path("CML"), `{"fabric":"CML","vrfName":"VRF1"}`); err != nil {
+		t.Fatalf("create vrf: %v", err)
+	}
+	attach := `[{"vrfName":"VRF1","lanAttachList":[{"fabric":"CML","vrfName":"VRF1","serialNumber":"9DBYO6WQJ46","vlan":2000,"deployment":true},{"fabric":"CML","vrfName":"VRF1","serialNumber":"9RB5Y9BFNTU","vlan":2000,"deployment":true}]}]`
+	if _, err := client.Post(ctx, VRFType.Path("CML")+"attachments", attach); err != nil {
+		t.Fatalf("attach vrf: %v", err)
+	}
+	if err := client.deploySwitches(ctx, VRFType, "VRF1", []string{"9DBYO6WQJ46"}); err != nil {
+		t.Fatalf("deploy on LEAF1: %v", err)
+	}
+
+	if err := client.WaitDeployments(ctx, VRFType, "CML", "VRF1"); err != nil {
+		t.Fatalf("wait: %v", err)
+	}
+	res, err := client.GetAttachments(ctx, VRFType, "CML", "VRF1")
+	if err != nil {
+		t.Fatalf("get attachments: %v", err)
+	}
+	if got := res.Get(`0.lanAttachList.#(switchSerialNo="9DBYO6WQJ46").lanAttachState`).String(); got != StateDeployed {
+		t.Errorf("LEAF1 state = %q, want %q", got, StateDeployed)
+	}
+	if got := res.Get(`0.lanAttachList.#(switchSerialNo="9RB5Y9BFNTU").lanAttachState`).String(); got != StatePending {
+		t.Errorf("LEAF2 state = %q, want %q", got, StatePending)
+	}
+}
+
+func TestGetAttachment(t *testing.T) {
+	client := newMockClient(t)
+	ctx := context.Background()
+
+	if _, err := client.Post(ctx, VRFType.Path("CML"), `{"fabric":"CML","vrfName":"VRF1"}`); err != nil {
+		t.Fatalf("create vrf: %v", err)
+	}
+	attach := `[{"vrfName":"VRF1","lanAttachList":[{"fabric":"CML","vrfName":"VRF1","serialNumber":"9DBYO6WQJ46","vlan":2000,"deployment":true}]}]`
+	if _, err := client.Post(ctx, VRFType.Path("CML")+"attachments", attach); err != nil {
+		t.Fatalf("attach vrf: %v", err)
+	}
+
+	entry, err := client.GetAttachment(ctx, VRFType, "CML", "VRF1", "9DBYO6WQJ46")
+	if err != nil {
+		t.Fatalf("get attachment: %v", err)
+	}
+	if !entry.Get("isLanAttached").Bool() || entry.Get("vlanId").Int() != 2000 {
+		t.Errorf("attachment = %s, want attached with VLAN 2000", entry.Raw)
+	}
+	entry, err = client.GetAttachment(ctx, VRFType, "CML", "VRF1", "9RB5Y9BFNTU")
+	if err != nil {
+		t.Fatalf("get attachment: %v", err)
+	}
+	if entry.Get("isLanAttached").Bool() {
+		t.Errorf("attachment of LEAF2 = %s, want detached", entry.Raw)
+	}
+
+	if _, err := client.GetAttachment(ctx, VRFType, "CML", "VRF1", "UNKNOWN"); !IsNotFound(err) {
+		t.Errorf("unknown switch: got %v, want not found error", err)
+	}
+	if _, err := client.GetAttachment(ctx, VRFType, "CML", "UNKNOWN", "9DBYO6WQJ46"); !IsNotFound(err) {
+		t.Errorf("unknown vrf: got %v, want not found error", err)
+	}
+}
@@ -0,0 +1,271 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package ndfc
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"syscall"
+
+	"github.com/tidwall/gjson"
+)
+
+// Kind classifies a failed request.
+type Kind int
+
+const (
+	KindUnknown Kind = iota
+	// KindNotFound means the object addressed by the request does not exist.
+	KindNotFound
+	// KindConflict means the object already exists or is in a state that
+	// does not allow the operation, e.g. it is still attached.
+	KindConflict
+	// KindValidation means NDFC rejected the payload.
+	KindValidation
+	// KindTransient means the request may succeed if retried.
+	KindTransient
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindNotFound:
+		return "not found"
+	case KindConflict:
+		return "conflict"
+	case KindValidation:
+		return "validation"
+	case KindTransient:
+		return "transient"
+	}
+	return "unknown"
+}
+
+// Error is returned by all Client requests that fail.
+type Error struct {
+	Kind   Kind
+	Method string
+	Path   string
+	// StatusCode is the HTTP status code, or 0 if no response was received.
+	StatusCode int
+	// Code is the NDFC error code, if any.
+	Code string
+	// Message is the error message reported by NDFC, if any.
+	Message string
+	// Field is the name of the payload field NDFC rejected, if it could be
+	// determined. For interfaces this is the nvPairs key.
+	Field string
+	// Response is the raw response body.
+	Response gjson.Result
+	Err      error
+}
+
+func (e *Error) Error() string {
+	if e.StatusCode == 0 {
+		return fmt.Sprintf("%s %s: %s", e.Method, e.Path, e.Err)
+	}
+	msg := fmt.Sprintf("%s %s: status %d", e.Method, e.Path, e.StatusCode)
+	if e.Code != "" {
+		msg += fmt.Sprintf(" (code %s)", e.Code)
+	}
+	switch {
+	case e.Message != "":
+		msg += ": " + e.Message
+	case e.Response.Raw != "":
+		msg += ": " + e.Response.Raw
+	}
+	return msg
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// IsNotFound reports whether err is an Error of KindNotFound.
+func IsNotFound(err error) bool {
+	return kindOf(err) == KindNotFound
+}
+
+// IsConflict reports whether err is an Error of KindConflict.
+func IsConflict(err error) bool {
+	return kindOf(err) == KindConflict
+}
+
+// IsValidation reports whether err is an Error of KindValidation.
+func IsValidation(err error) bool {
+	return kindOf(err) == KindValidation
+}
+
+// IsTransient reports whether err is an Error of KindTransient.
+func IsTransient(err error) bool {
+	return kindOf(err) == KindTransient
+}
+
+func kindOf(err error) Kind {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Kind
+	}
+	return KindUnknown
+}
+
+// newError wraps an error returned by go-nd, which only reports the status
+// code in its message, into an Error.
+func newError(method, path string, res gjson.Result, err error) *Error {
+	e := &Error{
+		Method:   method,
+		Path:     path,
+		Response: res,
+		Err:      err,
+		Code:     firstString(res, "code", "errorCode", "0.code", "0.errorCode"),
+		Message:  responseMessage(res),
+	}
+	e.Field = responseField(res, e.Message)
+	if _, scanErr := fmt.Sscanf(err.Error(), "HTTP Request failed: StatusCode %d", &e.StatusCode); scanErr != nil {
+		e.StatusCode = 0
+	}
+	if e.StatusCode == 0 {
+		e.Kind = classifyTransport(err)
+	} else {
+		e.Kind = classify(e.StatusCode, e.Message)
+	}
+	return e
+}
+
+func firstString(res gjson.Result, paths ...string) string {
+	for _, p := range paths {
+		if v := res.Get(p).String(); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// responseMessage extracts the error message from the different error
+// payloads returned by NDFC.
+func responseMessage(res gjson.Result) string {
+	if m := firstString(res, "message", "0.message", "errors.0.message", "error"); m != "" {
+		return m
+	}
+	if res.Type == gjson.String {
+		return res.String()
+	}
+	return ""
+}
+
+var fieldPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)invalid value for (?:field |parameter )?'?([A-Za-z_][A-Za-z0-9_.]*)'?`),
+	regexp.MustCompile(`(?i)(?:field|parameter) '?([A-Za-z_][A-Za-z0-9_.]*)'? (?:is|must|should|cannot|has)`),
+	regexp.MustCompile(`^'?([A-Za-z_][A-Za-z0-9_.]*)'? (?:is mandatory|is required|is invalid|must )`),
+}
+
+// responseField returns the payload field an error refers to, either from
+// the structured error or by matching the common NDFC message formats.
+func responseField(res gjson.Result, message string) string {
+	if f := firstString(res, "field", "fieldName", "0.field", "errors.0.field"); f != "" {
+		return f
+	}
+	for _, re := range fieldPatterns {
+		if m := re.FindStringSubmatch(message); m != nil {
+			return m[1]
+		}
+	}
+	return ""
+}
+
+func classifyTransport(err error) Kind {
+	var urlErr *url.Error
+	var netErr net.Error
+	if errors.As(err, &urlErr) || errors.As(err, &netErr) {
+		return KindTransient
+	}
+	return KindUnknown
+}
+
+// classify maps a status code and message to a Kind. NDFC reports most
+// errors, including missing objects, as 400 or 500, so the message is
+// checked before falling back to the status code.
+func classify(status int, message string) Kind {
+	switch status {
+	case 404:
+		return KindNotFound
+	case 409:
+		return KindConflict
+	case 408, 429, 502, 503, 504:
+		return KindTransient
+	}
+	m := strings.ToLower(message)
+	switch {
+	case containsAny(m, "not found", "not exist", "doesn't exist", "not present", "no such"):
+		return KindNotFound
+	case containsAny(m, "already exist", "in use", "is attached", "in progress"):
+		return KindConflict
+	}
+	switch {
+	case status == 400 || status == 422:
+		return KindValidation
+	case status >= 500:
+		return KindTransient
+	}
+	return KindUnknown
+}
+
+// retryable reports whether the failed request may be sent again. GET
+// requests are retried on any transient error. Mutating requests may have
+// been applied despite an error, so they are only retried if they were never
+// sent or a gateway or overloaded server rejected them.
+func (e *Error) retryable() bool {
+	if e.Kind != KindTransient {
+		return false
+	}
+	if e.Method == http.MethodGet {
+		return true
+	}
+	if e.StatusCode == 0 {
+		return notSent(e.Err)
+	}
+	switch e.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// notSent reports whether a transport error occurred before the request was
+// sent, because the connection could not be established. Timeouts and
+// connection resets may happen after NDFC received the request.
+func notSent(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNREFUSED)
+}
+
+func containsAny(s string, substrs ...string) bool {
+	for _, sub := range substrs {
+		if strings.Contains(s, sub) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package ndfc
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/tidwall/gjson"
+)
+
+func TestNewError(t *testing.T) {
+	cases := []struct {
+		status  int
+		body    string
+		kind    Kind
+		code    string
+		message string
+		field   string
+	}{
+		{
+			status:  400,
+			body:    `{"code":"INVALID_PARAM","message":"Invalid value","field":"vrfVlanId"}`,
+			kind:    KindValidation,
+			code:    "INVALID_PARAM",
+			message: "Invalid value",
+			field:   "vrfVlanId",
+		},
+		{
+			status:  400,
+			body:    `{"status":400,"message":"Invalid Value for vrfVlanId: 5000"}`,
+			kind:    KindValidation,
+			message: "Invalid Value for vrfVlanId: 5000",
+			field:   "vrfVlanId",
+		},
+		{
+			status:  400,
+			body:    `[{"reportItemType":"ERROR","message":"MTU is invalid"}]`,
+			kind:    KindValidation,
+			message: "MTU is invalid",
+			field:   "MTU",
+		},
+		{
+			status:  500,
+			body:    `{"message":"VRF VRF1 does not exist in fabric CML"}`,
+			kind:    KindNotFound,
+			message: "VRF VRF1 does not exist in fabric CML",
+		},
+		{
+			status: 500,
+			body:   `{"message":"Internal Server Error"}`,
+			kind:   KindTransient,
+		},
+		{
+			status: 400,
+			body:   `{"message":"VRF VRF1 already exists in fabric CML"}`,
+			kind:   KindConflict,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.body, func(t *testing.T) {
+			e := newError("POST", "/x", gjson.Parse(c.body), fmt.Errorf("HTTP Request failed: StatusCode %d", c.status))
+			if e.StatusCode != c.status {
+				t.Errorf("StatusCode = %d, want %d", e.StatusCode, c.status)
+			}
+			if e.Kind != c.kind {
+				t.Errorf("Kind = %v, want %v", e.Kind, c.kind)
+			}
+			if e.Code != c.code {
+				t.Errorf("Code = %q, want %q", e.Code, c.code)
+			}
+			if c.message != "" && e.Message != c.message {
+				t.Errorf("Message = %q, want %q", e.Message, c.message)
+			}
+			if e.Field != c.field {
+				t.Errorf("Field = %q, want %q", e.Field, c.field)
+			}
+		})
+	}
+}
+
+func TestNewErrorTransport(t *testing.T) {
+	e := newError("GET", "/x", gjson.Result{}, errors.New("Authentication failed"))
+	if e.StatusCode != 0 || e.Kind != KindUnknown {
+		t.Errorf("got status %d kind %v, want 0 unknown", e.StatusCode, e.Kind)
+	}
+}
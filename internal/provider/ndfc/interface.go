@@ -0,0 +1,148 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package ndfc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+const InterfacePath = "/lan-fabric/rest/interface"
+
+// InterfaceRef identifies an interface of a switch.
+type InterfaceRef struct {
+	SerialNumber string
+	IfName       string
+}
+
+func interfaceRefsBody(refs []InterfaceRef) string {
+	body := "[]"
+	for i, ref := range refs {
+		body, _ = sjson.Set(body, fmt.Sprintf("%d.serialNumber", i), ref.SerialNumber)
+		body, _ = sjson.Set(body, fmt.Sprintf("%d.ifName", i), ref.IfName)
+	}
+	return body
+}
+
+// GetInterface returns the policy and interface object of an interface. The
+// interface object is the first entry of the "interfaces" list returned by
+// NDFC.
+func (c *Client) GetInterface(ctx context.Context, ref InterfaceRef) (gjson.Result, error) {
+	return c.Get(ctx, fmt.Sprintf("%v?serialNumber=%v&ifName=%v", InterfacePath, ref.SerialNumber, ref.IfName))
+}
+
+// GetInterfaces returns the policy and interface objects of all interfaces
+// of a switch that NDFC manages.
+func (c *Client) GetInterfaces(ctx context.Context, serialNumber string) (gjson.Result, error) {
+	return c.Get(ctx, fmt.Sprintf("%v?serialNumber=%v", InterfacePath, serialNumber))
+}
+
+// InterfaceDetail is the operational view of an interface.
+type InterfaceDetail struct {
+	SerialNumber string
+	IfName       string
+	// Type is the NDFC interface type, e.g. INTERFACE_ETHERNET.
+	Type       string
+	Fabric     string
+	Policy     string
+	AdminState string
+	OperState  string
+	// Description is the interface description configured on the switch.
+	Description string
+	Vrf         string
+	// AllowedVlans holds the access VLAN for access ports.
+	AllowedVlans string
+	// NativeVlan is 0 if no native VLAN is configured.
+	NativeVlan int64
+}
+
+func newInterfaceDetail(v gjson.Result) InterfaceDetail {
+	return InterfaceDetail{
+		SerialNumber: v.Get("serialNo").String(),
+		IfName:       v.Get("ifName").String(),
+		Type:         v.Get("ifType").String(),
+		Fabric:       v.Get("fabricName").String(),
+		Policy:       v.Get("underlayPolicies.0.templateName").String(),
+		AdminState:   v.Get("adminStatusStr").String(),
+		OperState:    v.Get("operStatusStr").String(),
+		Description:  v.Get("alias").String(),
+		Vrf:          v.Get("vrf").String(),
+		AllowedVlans: v.Get("allowedVLANs").String(),
+		NativeVlan:   v.Get("nativeVlanId").Int(),
+	}
+}
+
+// GetInterfaceDetails returns the interfaces of the switch with
+// serialNumber, or of all switches of fabric if serialNumber is empty.
+func (c *Client) GetInterfaceDetails(ctx context.Context, fabric, serialNumber string) ([]InterfaceDetail, error) {
+	query := "fabricName=" + url.QueryEscape(fabric)
+	if serialNumber != "" {
+		query = "serialNumber=" + url.QueryEscape(serialNumber)
+	}
+	res, err := c.Get(ctx, InterfacePath+"/detail?"+query)
+	if err != nil {
+		return nil, err
+	}
+	details := []InterfaceDetail{}
+	res.ForEach(func(_, v gjson.Result) bool {
+		details = append(details, newInterfaceDetail(v))
+		return true
+	})
+	return details, nil
+}
+
+// DeleteInterfaces removes logical interfaces. The change only reaches the
+// switches once the interfaces are deployed.
+func (c *Client) DeleteInterfaces(ctx context.Context, refs ...InterfaceRef) (gjson.Result, error) {
+	return c.Delete(ctx, InterfacePath, interfaceRefsBody(refs))
+}
+
+// DeployInterfaces deploys the pending configuration of the given
+// interfaces. NDFC reports per interface failures in the response body with
+// a 200 status, those are returned as a KindValidation Error.
+func (c *Client) DeployInterfaces(ctx context.Context, refs ...InterfaceRef) (gjson.Result, error) {
+	path := InterfacePath + "/deploy"
+	res, err := c.Post(ctx, path, interfaceRefsBody(refs))
+	if err != nil {
+		return res, err
+	}
+	var report *Error
+	res.ForEach(func(_, item gjson.Result) bool {
+		if item.Get("reportItemType").String() == "ERROR" {
+			report = &Error{
+				Kind:       KindValidation,
+				Method:     http.MethodPost,
+				Path:       path,
+				StatusCode: http.StatusOK,
+				Message:    item.Get("message").String(),
+				Response:   res,
+			}
+			return false
+		}
+		return true
+	})
+	if report != nil {
+		return res, report
+	}
+	return res, nil
+}
@@ -0,0 +1,68 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package ndfc
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetInterfaceDetails(t *testing.T) {
+	client := newMockClient(t)
+	ctx := context.Background()
+
+	body := `{"policy":"int_access_host","interfaces":[{"serialNumber":"9DBYO6WQJ46","ifName":"Ethernet1/10","nvPairs":{"INTF_NAME":"Ethernet1/10","ACCESS_VLAN":"500","DESC":"server","ADMIN_STATE":"false"}}]}`
+	if _, err := client.Put(ctx, InterfacePath, body); err != nil {
+		t.Fatalf("configure interface: %v", err)
+	}
+	body = `{"policy":"int_loopback","interfaces":[{"serialNumber":"9DBYO6WQJ46","ifName":"loopback10","nvPairs":{"INTF_NAME":"loopback10","INTF_VRF":"VRF1"}}]}`
+	if _, err := client.Post(ctx, InterfacePath, body); err != nil {
+		t.Fatalf("create interface: %v", err)
+	}
+
+	details, err := client.GetInterfaceDetails(ctx, "", "9DBYO6WQJ46")
+	if err != nil {
+		t.Fatalf("get interface details: %v", err)
+	}
+	found := map[string]InterfaceDetail{}
+	for _, d := range details {
+		found[d.IfName] = d
+	}
+	eth := found["Ethernet1/10"]
+	if eth.Type != "INTERFACE_ETHERNET" || eth.Policy != "int_access_host" || eth.AllowedVlans != "500" || eth.Description != "server" || eth.AdminState != "down" {
+		t.Errorf("Ethernet1/10 = %+v", eth)
+	}
+	if lo := found["loopback10"]; lo.Type != "INTERFACE_LOOPBACK" || lo.Vrf != "VRF1" {
+		t.Errorf("loopback10 = %+v", lo)
+	}
+	if nve := found["nve1"]; nve.Type != "INTERFACE_NVE" || nve.Policy != "nve" {
+		t.Errorf("nve1 = %+v", nve)
+	}
+
+	all, err := client.GetInterfaceDetails(ctx, "CML", "")
+	if err != nil {
+		t.Fatalf("get fabric interface details: %v", err)
+	}
+	if len(all) <= len(details) {
+		t.Errorf("got %d fabric interfaces, want more than the %d of one switch", len(all), len(details))
+	}
+
+	if _, err := client.GetInterfaceDetails(ctx, "", "UNKNOWN"); !IsNotFound(err) {
+		t.Errorf("unknown switch: got %v, want not found error", err)
+	}
+}
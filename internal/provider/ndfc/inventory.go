@@ -0,0 +1,295 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package ndfc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// Switch modes, discovery and config compliance states as reported in the
+// fabric inventory.
+const (
+	ModeNormal    = "Normal"
+	ModeMigration = "Migration"
+
+	SwitchStatusOk = "ok"
+
+	ConfigInSync    = "In-Sync"
+	ConfigOutOfSync = "Out-of-Sync"
+)
+
+// FabricPath returns the path of fabric in the control API.
+func FabricPath(fabric string) string {
+	return "/lan-fabric/rest/control/fabrics/" + url.PathEscape(fabric)
+}
+
+// Switch is a switch of a fabric inventory.
+type Switch struct {
+	SerialNumber string
+	IpAddress    string
+	Hostname     string
+	Role         string
+	Model        string
+	Version      string
+	// Mode is ModeMigration until the switch configuration has been brought
+	// in line with the fabric.
+	Mode string
+	// Status is the discovery status, SwitchStatusOk once the switch has
+	// been discovered.
+	Status string
+	// VpcPeer is the serial number of the vPC peer, empty if the switch is
+	// not part of a vPC pair.
+	VpcPeer   string
+	VpcDomain int64
+	// ConfigStatus is the config compliance status, ConfigInSync once all
+	// pending configuration has been deployed.
+	ConfigStatus string
+}
+
+func newSwitch(v gjson.Result) Switch {
+	return Switch{
+		SerialNumber: v.Get("serialNumber").String(),
+		IpAddress:    v.Get("ipAddress").String(),
+		Hostname:     v.Get("logicalName").String(),
+		Role:         v.Get("switchRole").String(),
+		Model:        v.Get("model").String(),
+		Version:      v.Get("release").String(),
+		Mode:         v.Get("mode").String(),
+		Status:       v.Get("status").String(),
+		VpcPeer:      v.Get("peerSerialNumber").String(),
+		VpcDomain:    v.Get("vpcDomain").Int(),
+		ConfigStatus: v.Get("ccStatus").String(),
+	}
+}
+
+// GetSwitches returns the switches of fabric.
+func (c *Client) GetSwitches(ctx context.Context, fabric string) ([]Switch, error) {
+	res, err := c.Get(ctx, FabricPath(fabric)+"/inventory/switchesByFabric")
+	if err != nil {
+		return nil, err
+	}
+	switches := []Switch{}
+	res.ForEach(func(_, v gjson.Result) bool {
+		switches = append(switches, newSwitch(v))
+		return true
+	})
+	return switches, nil
+}
+
+// DiscoveryOptions are the parameters of a switch discovery.
+type DiscoveryOptions struct {
+	Username string
+	Password string
+	// MaxHops is the number of hops from the seed switches up to which
+	// neighbors are discovered as well.
+	MaxHops int64
+	// PreserveConfig keeps the existing switch configuration (brownfield).
+	PreserveConfig bool
+}
+
+func (o DiscoveryOptions) body(seedIPs []string) string {
+	body := ""
+	body, _ = sjson.Set(body, "seedIP", strings.Join(seedIPs, ","))
+	body, _ = sjson.Set(body, "username", o.Username)
+	body, _ = sjson.Set(body, "password", o.Password)
+	body, _ = sjson.Set(body, "maxHops", o.MaxHops)
+	body, _ = sjson.Set(body, "preserveConfig", o.PreserveConfig)
+	body, _ = sjson.Set(body, "snmpV3AuthProtocol", 0)
+	body, _ = sjson.Set(body, "cdpSecondTimeout", 5)
+	return body
+}
+
+// DiscoverSwitches adds the switches at seedIPs to fabric. Discovery runs in
+// the background, see WaitForSwitches. Switches that cannot be reached or
+// logged into are reported as a KindValidation Error before any switch is
+// added.
+func (c *Client) DiscoverSwitches(ctx context.Context, fabric string, opts DiscoveryOptions, seedIPs ...string) error {
+	path := FabricPath(fabric) + "/inventory/test-reachability"
+	body := opts.body(seedIPs)
+	res, err := c.Post(ctx, path, body)
+	if err != nil {
+		return err
+	}
+	var failed []string
+	for _, ip := range seedIPs {
+		v := res.Get(fmt.Sprintf(`#(ipaddr==%q)`, ip))
+		switch {
+		case !v.Get("reachable").Bool():
+			failed = append(failed, ip+" (unreachable)")
+		case !v.Get("auth").Bool():
+			failed = append(failed, ip+" (authentication failed)")
+		case !v.Get("selectable").Bool():
+			failed = append(failed, ip+" (already managed)")
+		default:
+			body, _ = sjson.SetRaw(body, "switches.-1", v.Raw)
+		}
+	}
+	if len(failed) > 0 {
+		return &Error{
+			Kind:       KindValidation,
+			Method:     http.MethodPost,
+			Path:       path,
+			StatusCode: http.StatusOK,
+			Message:    "switches cannot be discovered: " + strings.Join(failed, ", "),
+			Response:   res,
+		}
+	}
+	_, err = c.Post(ctx, FabricPath(fabric)+"/inventory/discover", body)
+	return err
+}
+
+// SetSwitchRoles assigns roles, e.g. "leaf" or "border gateway", to
+// switches. roles maps serial numbers to roles.
+func (c *Client) SetSwitchRoles(ctx context.Context, roles map[string]string) error {
+	serials := make([]string, 0, len(roles))
+	for serial := range roles {
+		serials = append(serials, serial)
+	}
+	sort.Strings(serials)
+	body := "[]"
+	for i, serial := range serials {
+		body, _ = sjson.Set(body, fmt.Sprintf("%d.serialNumber", i), serial)
+		body, _ = sjson.Set(body, fmt.Sprintf("%d.role", i), roles[serial])
+	}
+	_, err := c.Post(ctx, "/lan-fabric/rest/control/switches/roles", body)
+	return err
+}
+
+// RemoveSwitches removes switches from fabric.
+func (c *Client) RemoveSwitches(ctx context.Context, fabric string, serials ...string) error {
+	for _, serial := range serials {
+		if _, err := c.Delete(ctx, FabricPath(fabric)+"/switches/"+url.PathEscape(serial), ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeployFabric recalculates the configuration of all switches of fabric
+// and deploys it.
+func (c *Client) DeployFabric(ctx context.Context, fabric string) error {
+	if _, err := c.Post(ctx, FabricPath(fabric)+"/config-save", ""); err != nil {
+		return err
+	}
+	_, err := c.Post(ctx, FabricPath(fabric)+"/config-deploy?forceShowRun=false", "")
+	return err
+}
+
+// DeploySwitches recalculates the configuration of fabric and deploys it to
+// the switches with the given serial numbers only.
+func (c *Client) DeploySwitches(ctx context.Context, fabric string, serials ...string) error {
+	if _, err := c.Post(ctx, FabricPath(fabric)+"/config-save", ""); err != nil {
+		return err
+	}
+	path := fmt.Sprintf("%v/config-deploy/%v?forceShowRun=false", FabricPath(fabric), strings.Join(serials, ","))
+	_, err := c.Post(ctx, path, "")
+	return err
+}
+
+// GetSwitchFabric returns the name of the fabric the switch with serial
+// number serial is part of.
+func (c *Client) GetSwitchFabric(ctx context.Context, serial string) (string, error) {
+	res, err := c.Get(ctx, "/lan-fabric/rest/control/switches/"+url.PathEscape(serial)+"/fabric-name")
+	if err != nil {
+		return "", err
+	}
+	return res.Get("fabricName").String(), nil
+}
+
+// GetFabricSettings returns the template parameters of a fabric, e.g.
+// REPLICATION_MODE.
+func (c *Client) GetFabricSettings(ctx context.Context, fabric string) (gjson.Result, error) {
+	res, err := c.Get(ctx, FabricPath(fabric))
+	if err != nil {
+		return res, err
+	}
+	return res.Get("nvPairs"), nil
+}
+
+// SwitchError is returned by WaitForSwitches if some switches failed.
+type SwitchError struct {
+	Fabric string
+	// Failed maps the IP addresses of the failed switches to their status.
+	Failed map[string]string
+}
+
+func (e *SwitchError) Error() string {
+	ips := make([]string, 0, len(e.Failed))
+	for ip := range e.Failed {
+		ips = append(ips, ip)
+	}
+	sort.Strings(ips)
+	failed := make([]string, len(ips))
+	for i, ip := range ips {
+		failed[i] = fmt.Sprintf("%v (%v)", ip, e.Failed[ip])
+	}
+	return fmt.Sprintf("switches of fabric %v failed: %v", e.Fabric, strings.Join(failed, ", "))
+}
+
+// WaitForSwitches waits until the switches at ips have been discovered into
+// fabric and, if migrated is set, also left migration mode. It returns the
+// switches, keyed by IP address. Switches whose discovery failed are
+// reported with a SwitchError. WaitForSwitches waits until ctx is done.
+func (c *Client) WaitForSwitches(ctx context.Context, fabric string, migrated bool, ips ...string) (map[string]Switch, error) {
+	found := map[string]Switch{}
+	failed := map[string]string{}
+	err := Poll(ctx, func(ctx context.Context) (bool, error) {
+		switches, err := c.GetSwitches(ctx, fabric)
+		if err != nil {
+			return false, err
+		}
+		byIP := make(map[string]Switch, len(switches))
+		for _, sw := range switches {
+			byIP[sw.IpAddress] = sw
+		}
+		done := true
+		for _, ip := range ips {
+			sw, ok := byIP[ip]
+			switch {
+			case !ok || sw.Status == "" || strings.Contains(strings.ToLower(sw.Status), "discovering"):
+				done = false
+			case sw.Status != SwitchStatusOk:
+				failed[ip] = sw.Status
+			case migrated && sw.Mode == ModeMigration:
+				done = false
+			default:
+				found[ip] = sw
+			}
+		}
+		if !done {
+			tflog.Debug(ctx, fmt.Sprintf("%v: waiting for %v of %v switches", fabric, len(ips)-len(found)-len(failed), len(ips)))
+		}
+		return done || len(failed) > 0, nil
+	})
+	if err != nil {
+		return found, err
+	}
+	if len(failed) > 0 {
+		return found, &SwitchError{Fabric: fabric, Failed: failed}
+	}
+	return found, nil
+}
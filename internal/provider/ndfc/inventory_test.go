@@ -0,0 +1,186 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package ndfc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfcmock"
+)
+
+func TestDiscoverSwitches(t *testing.T) {
+	defer func(p time.Duration) { PollInterval = p }(PollInterval)
+	PollInterval = time.Millisecond
+
+	client := newMockClient(t)
+	ctx := context.Background()
+	opts := DiscoveryOptions{Username: ndfcmock.DefaultUsername, Password: ndfcmock.DefaultPassword}
+
+	err := client.DiscoverSwitches(ctx, "CML", DiscoveryOptions{Username: "admin", Password: "wrong"}, "10.0.0.103")
+	if !IsValidation(err) {
+		t.Fatalf("discover with wrong password: got %v, want validation error", err)
+	}
+	if err := client.DiscoverSwitches(ctx, "CML", opts, "10.0.0.101"); !IsValidation(err) {
+		t.Fatalf("discover managed switch: got %v, want validation error", err)
+	}
+
+	if err := client.DiscoverSwitches(ctx, "CML", opts, "10.0.0.103", "10.0.0.111"); err != nil {
+		t.Fatalf("discover: %v", err)
+	}
+	switches, err := client.WaitForSwitches(ctx, "CML", false, "10.0.0.103", "10.0.0.111")
+	if err != nil {
+		t.Fatalf("wait for discovery: %v", err)
+	}
+	if got := switches["10.0.0.103"].SerialNumber; got != "9TQYTJSZ1VJ" {
+		t.Errorf("serial number = %q, want %q", got, "9TQYTJSZ1VJ")
+	}
+	if got := switches["10.0.0.111"].Mode; got != ModeMigration {
+		t.Errorf("mode = %q, want %q", got, ModeMigration)
+	}
+
+	if err := client.SetSwitchRoles(ctx, map[string]string{"9PN0KPO2XKN": "border gateway"}); err != nil {
+		t.Fatalf("set roles: %v", err)
+	}
+	if err := client.DeployFabric(ctx, "CML"); err != nil {
+		t.Fatalf("deploy fabric: %v", err)
+	}
+	switches, err = client.WaitForSwitches(ctx, "CML", true, "10.0.0.103", "10.0.0.111")
+	if err != nil {
+		t.Fatalf("wait for migration: %v", err)
+	}
+	if got := switches["10.0.0.111"].Role; got != "border gateway" {
+		t.Errorf("role = %q, want %q", got, "border gateway")
+	}
+	if got := switches["10.0.0.111"].Mode; got != ModeNormal {
+		t.Errorf("mode = %q, want %q", got, ModeNormal)
+	}
+
+	if err := client.RemoveSwitches(ctx, "CML", "9TQYTJSZ1VJ", "9PN0KPO2XKN"); err != nil {
+		t.Fatalf("remove switches: %v", err)
+	}
+	all, err := client.GetSwitches(ctx, "CML")
+	if err != nil {
+		t.Fatalf("get switches: %v", err)
+	}
+	if len(all) != len(ndfcmock.DefaultSwitches) {
+		t.Errorf("got %d switches, want %d", len(all), len(ndfcmock.DefaultSwitches))
+	}
+}
+
+func TestDeploySwitches(t *testing.T) {
+	client := newMockClient(t)
+	ctx := context.Background()
+
+	fabric, err := client.GetSwitchFabric(ctx, "9DBYO6WQJ46")
+	if err != nil {
+		t.Fatalf("get switch fabric: %v", err)
+	}
+	if fabric != "CML" {
+		t.Errorf("fabric = %q, want %q", fabric, "CML")
+	}
+	if _, err := client.GetSwitchFabric(ctx, "UNKNOWN"); !IsNotFound(err) {
+		t.Errorf("get fabric of unknown switch: got %v, want not found", err)
+	}
+
+	if _, err := client.Post(ctx, VpcPairPath, `{"peerOneId":"9DBYO6WQJ46","peerTwoId":"9RB5Y9BFNTU"}`); err != nil {
+		t.Fatalf("create vpc pair: %v", err)
+	}
+	if err := client.DeploySwitches(ctx, fabric, "9DBYO6WQJ46", "9RB5Y9BFNTU"); err != nil {
+		t.Fatalf("deploy switches: %v", err)
+	}
+	status, err := client.GetVpcPairConsistency(ctx, "9RB5Y9BFNTU")
+	if err != nil {
+		t.Fatalf("get consistency: %v", err)
+	}
+	if status != "consistent" {
+		t.Errorf("consistency = %q, want %q", status, "consistent")
+	}
+	switches, err := client.GetSwitches(ctx, fabric)
+	if err != nil {
+		t.Fatalf("get switches: %v", err)
+	}
+	for _, sw := range switches {
+		if sw.SerialNumber == "9DBYO6WQJ46" && sw.VpcPeer != "9RB5Y9BFNTU" {
+			t.Errorf("vpc peer = %q, want %q", sw.VpcPeer, "9RB5Y9BFNTU")
+		}
+	}
+}
+
+func TestGetFabricSettings(t *testing.T) {
+	client := newMockClient(t)
+	ctx := context.Background()
+
+	settings, err := client.GetFabricSettings(ctx, "CML")
+	if err != nil {
+		t.Fatalf("get fabric settings: %v", err)
+	}
+	if got := settings.Get("REPLICATION_MODE").String(); got != "Multicast" {
+		t.Errorf("REPLICATION_MODE = %q, want %q", got, "Multicast")
+	}
+	if _, err := client.GetFabricSettings(ctx, "UNKNOWN"); !IsNotFound(err) {
+		t.Errorf("get settings of unknown fabric: got %v, want not found", err)
+	}
+}
+
+func TestSwitchConfigStatus(t *testing.T) {
+	client := newMockClient(t)
+	ctx := context.Background()
+
+	configStatus := func() string {
+		t.Helper()
+		switches, err := client.GetSwitches(ctx, "CML")
+		if err != nil {
+			t.Fatalf("get switches: %v", err)
+		}
+		for _, sw := range switches {
+			if sw.SerialNumber == "9DBYO6WQJ46" {
+				return sw.ConfigStatus
+			}
+		}
+		t.Fatal("switch 9DBYO6WQJ46 not found")
+		return ""
+	}
+
+	if got := configStatus(); got != ConfigInSync {
+		t.Fatalf("config status = %q, want %q", got, ConfigInSync)
+	}
+	body := `{"policy":"int_access_host","interfaces":[{"serialNumber":"9DBYO6WQJ46","ifName":"Ethernet1/10","nvPairs":{"INTF_NAME":"Ethernet1/10","ACCESS_VLAN":"500"}}]}`
+	if _, err := client.Put(ctx, InterfacePath, body); err != nil {
+		t.Fatalf("configure interface: %v", err)
+	}
+	if got := configStatus(); got != ConfigOutOfSync {
+		t.Errorf("config status with pending interface = %q, want %q", got, ConfigOutOfSync)
+	}
+
+	// Deploying the switch deploys the pending interface configuration
+	if err := client.DeploySwitches(ctx, "CML", "9DBYO6WQJ46"); err != nil {
+		t.Fatalf("deploy switch: %v", err)
+	}
+	if got := configStatus(); got != ConfigInSync {
+		t.Errorf("config status after deploy = %q, want %q", got, ConfigInSync)
+	}
+	res, err := client.GetInterface(ctx, InterfaceRef{SerialNumber: "9DBYO6WQJ46", IfName: "Ethernet1/10"})
+	if err != nil {
+		t.Fatalf("get interface: %v", err)
+	}
+	if got := res.Get("0.interfaces.0.complianceStatus").String(); got != ConfigInSync {
+		t.Errorf("interface compliance status = %q, want %q", got, ConfigInSync)
+	}
+}
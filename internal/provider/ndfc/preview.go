@@ -0,0 +1,109 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package ndfc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+// configString returns a configuration returned either as a single string or
+// as a list of lines.
+func configString(v gjson.Result) string {
+	if !v.IsArray() {
+		return v.String()
+	}
+	var lines []string
+	v.ForEach(func(_, line gjson.Result) bool {
+		lines = append(lines, strings.TrimRight(line.String(), "\n"))
+		return true
+	})
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// GetSwitchPreview returns the configuration a deployment of the switch with
+// serial number serial would push, empty if there is nothing to deploy.
+func (c *Client) GetSwitchPreview(ctx context.Context, fabric, serial string) (string, error) {
+	path := fmt.Sprintf("%v/config-preview/%v?forceShowRun=false&showBrief=false", FabricPath(fabric), url.PathEscape(serial))
+	res, err := c.Get(ctx, path)
+	if err != nil {
+		return "", err
+	}
+	entry := res.Get(fmt.Sprintf(`#(switchId==%q)`, serial))
+	if !entry.Exists() {
+		return "", &Error{
+			Kind:     KindNotFound,
+			Method:   http.MethodGet,
+			Path:     path,
+			Message:  fmt.Sprintf("no preview returned for switch %v", serial),
+			Response: res,
+		}
+	}
+	return configString(entry.Get("pendingConfig")), nil
+}
+
+// GetPreview returns the configuration a deployment of the named object
+// would push to the switch with serial number serial, empty if there is
+// nothing to deploy.
+func (c *Client) GetPreview(ctx context.Context, t ObjectType, fabric, name, serial string) (string, error) {
+	path := fmt.Sprintf("%vpreviews?%v=%v&serial-numbers=%v", t.Path(fabric), t.NamesQuery, url.QueryEscape(name), url.QueryEscape(serial))
+	res, err := c.Get(ctx, path)
+	if err != nil {
+		return "", err
+	}
+	entry := res.Get(fmt.Sprintf(`#(%v==%q)#|#(switchSerialNo==%q)`, t.NameField, name, serial))
+	if !entry.Exists() {
+		return "", &Error{
+			Kind:     KindNotFound,
+			Method:   http.MethodGet,
+			Path:     path,
+			Message:  fmt.Sprintf("no preview returned for %v %v on switch %v", t.Name, name, serial),
+			Response: res,
+		}
+	}
+	return configString(entry.Get("configs")), nil
+}
+
+// InterfaceConfig returns the section of config configuring the interface
+// ifName, including the "interface" line, empty if there is none. Interface
+// names are compared case-insensitively.
+func InterfaceConfig(config, ifName string) string {
+	var b strings.Builder
+	in := false
+	for _, line := range strings.Split(config, "\n") {
+		switch {
+		case strings.HasPrefix(line, " "):
+		case strings.EqualFold(strings.TrimSpace(line), "interface "+ifName):
+			in = true
+		default:
+			in = false
+		}
+		if in {
+			b.WriteString(line + "\n")
+		}
+	}
+	return b.String()
+}
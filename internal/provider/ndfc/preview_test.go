@@ -0,0 +1,125 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package ndfc
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/tidwall/gjson"
+)
+
+func TestGetSwitchPreview(t *testing.T) {
+	client := newMockClient(t)
+	ctx := context.Background()
+
+	config, err := client.GetSwitchPreview(ctx, "CML", "9DBYO6WQJ46")
+	if err != nil {
+		t.Fatalf("get preview: %v", err)
+	}
+	if config != "" {
+		t.Errorf("preview without changes = %q, want empty", config)
+	}
+
+	body := `{"policy":"int_access_host","interfaces":[{"serialNumber":"9DBYO6WQJ46","ifName":"Ethernet1/10","nvPairs":{"INTF_NAME":"Ethernet1/10","ACCESS_VLAN":"500","DESC":"server"}}]}`
+	if _, err := client.Put(ctx, InterfacePath, body); err != nil {
+		t.Fatalf("configure interface: %v", err)
+	}
+	config, err = client.GetSwitchPreview(ctx, "CML", "9DBYO6WQJ46")
+	if err != nil {
+		t.Fatalf("get preview: %v", err)
+	}
+	if !strings.Contains(config, "interface Ethernet1/10\n") || !strings.Contains(config, "  switchport access vlan 500\n") {
+		t.Errorf("preview = %q, want Ethernet1/10 config", config)
+	}
+	if other, _ := client.GetSwitchPreview(ctx, "CML", "9RB5Y9BFNTU"); other != "" {
+		t.Errorf("preview of LEAF2 = %q, want empty", other)
+	}
+
+	if _, err := client.GetSwitchPreview(ctx, "CML", "UNKNOWN"); err == nil {
+		t.Errorf("unknown switch: got no error")
+	}
+}
+
+func TestGetPreview(t *testing.T) {
+	client := newMockClient(t)
+	ctx := context.Background()
+
+	if _, err := client.Post(ctx, VRFType.Path("CML"), `{"fabric":"CML","vrfName":"VRF1","vrfId":50001}`); err != nil {
+		t.Fatalf("create vrf: %v", err)
+	}
+	attach := `[{"vrfName":"VRF1","lanAttachList":[{"fabric":"CML","vrfName":"VRF1","serialNumber":"9DBYO6WQJ46","vlan":-1,"deployment":true}]}]`
+	if _, err := client.Post(ctx, VRFType.Path("CML")+"attachments", attach); err != nil {
+		t.Fatalf("attach vrf: %v", err)
+	}
+
+	config, err := client.GetPreview(ctx, VRFType, "CML", "VRF1", "9DBYO6WQJ46")
+	if err != nil {
+		t.Fatalf("get preview: %v", err)
+	}
+	if !strings.Contains(config, "vrf context VRF1\n") {
+		t.Errorf("preview = %q, want vrf context", config)
+	}
+	config, err = client.GetPreview(ctx, VRFType, "CML", "VRF1", "9RB5Y9BFNTU")
+	if err != nil {
+		t.Fatalf("get preview: %v", err)
+	}
+	if config != "" {
+		t.Errorf("preview of unattached switch = %q, want empty", config)
+	}
+
+	switchConfig, err := client.GetSwitchPreview(ctx, "CML", "9DBYO6WQJ46")
+	if err != nil {
+		t.Fatalf("get switch preview: %v", err)
+	}
+	if !strings.Contains(switchConfig, "vrf context VRF1\n") {
+		t.Errorf("switch preview = %q, want vrf context", switchConfig)
+	}
+
+	if _, err := client.GetPreview(ctx, VRFType, "CML", "UNKNOWN", "9DBYO6WQJ46"); !IsNotFound(err) {
+		t.Errorf("unknown vrf: got %v, want not found error", err)
+	}
+}
+
+func TestConfigString(t *testing.T) {
+	tests := []struct {
+		json string
+		want string
+	}{
+		{`"vlan 10\n"`, "vlan 10\n"},
+		{`["vlan 10", "  vn-segment 30000"]`, "vlan 10\n  vn-segment 30000\n"},
+		{`[]`, ""},
+	}
+	for _, tt := range tests {
+		if got := configString(gjson.Parse(tt.json)); got != tt.want {
+			t.Errorf("configString(%s) = %q, want %q", tt.json, got, tt.want)
+		}
+	}
+}
+
+func TestInterfaceConfig(t *testing.T) {
+	config := "interface Ethernet1/1\n  no shutdown\ninterface Ethernet1/10\n  description server\n  no shutdown\nvlan 10\n  vn-segment 30000\ninterface ethernet1/10\n  switchport trunk allowed vlan add 10\n"
+	want := "interface Ethernet1/10\n  description server\n  no shutdown\ninterface ethernet1/10\n  switchport trunk allowed vlan add 10\n"
+	if got := InterfaceConfig(config, "Ethernet1/10"); got != want {
+		t.Errorf("InterfaceConfig = %q, want %q", got, want)
+	}
+	if got := InterfaceConfig(config, "Ethernet1/2"); got != "" {
+		t.Errorf("InterfaceConfig of unconfigured interface = %q, want empty", got)
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package ndfc
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/tidwall/gjson"
+)
+
+const TemplatePath = "/configtemplate/rest/config/templates"
+
+// GetTemplateDefaults returns the default values of the parameters of the
+// template, e.g. an interface policy, by parameter name. Internal parameters
+// and parameters without a default value are left out.
+func (c *Client) GetTemplateDefaults(ctx context.Context, name string) (map[string]string, error) {
+	res, err := c.Get(ctx, TemplatePath+"/"+url.PathEscape(name))
+	if err != nil {
+		return nil, err
+	}
+	defaults := map[string]string{}
+	res.Get("parameters").ForEach(func(_, p gjson.Result) bool {
+		value := p.Get("metaProperties.defaultValue")
+		if p.Get("annotations.IsInternal").Bool() || !value.Exists() {
+			return true
+		}
+		defaults[p.Get("name").String()] = value.String()
+		return true
+	})
+	return defaults, nil
+}
@@ -0,0 +1,46 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package ndfc
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGetTemplateDefaults(t *testing.T) {
+	client := newMockClient(t)
+	ctx := context.Background()
+
+	defaults, err := client.GetTemplateDefaults(ctx, "int_trunk_host")
+	if err != nil {
+		t.Fatalf("get template defaults: %v", err)
+	}
+	if defaults["ALLOWED_VLANS"] != "none" || defaults["MTU"] != "jumbo" {
+		t.Errorf("defaults = %v, want ALLOWED_VLANS none and MTU jumbo", defaults)
+	}
+	if _, ok := defaults["POLICY_ID"]; ok {
+		t.Errorf("defaults = %v, want no internal parameters", defaults)
+	}
+	if _, ok := defaults["NATIVE_VLAN"]; ok {
+		t.Errorf("defaults = %v, want no parameters without default", defaults)
+	}
+
+	if _, err := client.GetTemplateDefaults(ctx, "MISSING"); !IsNotFound(err) {
+		t.Errorf("unknown template: got %v, want not found", err)
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package ndfc
+
+import (
+	"context"
+	"net/url"
+)
+
+// VpcPairPath is the path of the vPC pair API.
+const VpcPairPath = "/lan-fabric/rest/vpcpair"
+
+// GetVpcPairConsistency returns the consistency status of the vPC pair the
+// switch with serial number serial is part of, e.g. "consistent".
+func (c *Client) GetVpcPairConsistency(ctx context.Context, serial string) (string, error) {
+	res, err := c.Get(ctx, VpcPairPath+"/overview?serialNumber="+url.QueryEscape(serial))
+	if err != nil {
+		return "", err
+	}
+	return res.Get("overview.consistencyStatus").String(), nil
+}
@@ -0,0 +1,65 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package ndfc
+
+import (
+	"context"
+	"time"
+)
+
+var (
+	// PollInterval is the delay before the second status check of Poll. It
+	// doubles with every further check.
+	PollInterval = 4 * time.Second
+	// MaxPollInterval caps the delay between two status checks.
+	MaxPollInterval = 30 * time.Second
+)
+
+// Wait blocks for d or until ctx is done, in which case it returns the
+// context error.
+func Wait(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// Poll calls check until it reports done, returns an error or ctx is done.
+// The first check happens immediately, further checks back off from
+// PollInterval to MaxPollInterval. Deadlines are meant to be set by the
+// caller, usually from the timeouts block of a resource.
+func Poll(ctx context.Context, check func(ctx context.Context) (bool, error)) error {
+	delay := PollInterval
+	for {
+		done, err := check(ctx)
+		if err != nil || done {
+			return err
+		}
+		if err := Wait(ctx, delay); err != nil {
+			return err
+		}
+		delay *= 2
+		if delay > MaxPollInterval {
+			delay = MaxPollInterval
+		}
+	}
+}
@@ -19,12 +19,10 @@ package provider
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log"
 	"net/url"
 	"os"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -32,7 +30,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/netascode/terraform-provider-ndfc/internal/provider/helpers"
 	"github.com/tidwall/gjson"
-	"github.com/tidwall/sjson"
 )
 
 func logit() {
@@ -73,212 +70,41 @@ func ndfcCheckDiags(diags diag.Diagnostics, a Any) bool {
 	return false
 }
 
-func (client *NdfcClient) ndfcRestApiRequest(ctx context.Context, requestType string, path string, payLoad string) (gjson.Result, error, diag.Diagnostics) {
-	var res gjson.Result
-	var err error
+func (client *VRFResource) ndfcRestApiRequest(ctx context.Context, requestType string, path string, payLoad string) (gjson.Result, error, diag.Diagnostics) {
 	var diags diag.Diagnostics
-request_retry:
-	client.updateMutex.Lock()
-	switch requestType {
-	case "GET":
-		res, err = client.client.Get(path)
-	case "POST":
-		res, err = client.client.Post(path, payLoad)
-	case "PUT":
-		res, err = client.client.Put(path, payLoad)
-	case "DELETE":
-		res, err = client.client.Delete(path, "")
-	default:
-		tflog.Debug(ctx, fmt.Sprintf("request type not found : %v", requestType))
-		err = errors.New("wrong request type")
-	}
-	client.updateMutex.Unlock()
+	res, err := client.client.Do(ctx, requestType, path, payLoad)
 	tflog.Debug(ctx, fmt.Sprintf(" ndfcRestApiRequest requesttype: %v path %v res : %v  err %v payload",
 		requestType, path, res.String(), err))
 	if err != nil {
-		if strings.Contains(res.String(), "connect: operation timed out") {
-			// Sometimes connection to NDFC fails, we retry till we connect
-			tflog.Debug(ctx, res.String())
-			time.Sleep(10 * time.Second)
-			goto request_retry
-		}
-		if strings.Contains(err.Error(), "connection refused") {
-			// Sometimes connection to NDFC fails, we retry till we connect
-			tflog.Debug(ctx, res.String())
-			time.Sleep(10 * time.Second)
-			goto request_retry
-		}
-		diags.AddError("Client Error",
-			fmt.Sprintf("Failed to perform operation (%s) got error: %s, %s", requestType, err, res.String()))
+		diags.Append(helpers.ClientError(fmt.Sprintf("Failed to perform operation (%s)", requestType), err, nil))
 	}
 
 	return res, err, diags
 }
 
-func (v *VRF) ndfcSetTimeOut(ctx context.Context, operation string) (context.Context, diag.Diagnostics) {
+// ndfcSetTimeOut returns ctx with the deadline configured in the timeouts
+// block for operation. The returned cancel function must be called once the
+// operation is done.
+func (v *VRF) ndfcSetTimeOut(ctx context.Context, operation string) (context.Context, context.CancelFunc, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	var timeout time.Duration
 
 	switch operation {
 	case "CREATE":
-		timeout, diags = v.Timeouts.Create(ctx, time.Minute)
+		timeout, diags = v.Timeouts.Create(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
 	case "UPDATE":
-		timeout, diags = v.Timeouts.Update(ctx, time.Minute)
+		timeout, diags = v.Timeouts.Update(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
 	case "DELETE":
-		timeout, diags = v.Timeouts.Delete(ctx, time.Minute)
+		timeout, diags = v.Timeouts.Delete(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
 	case "READ":
-		timeout, diags = v.Timeouts.Read(ctx, time.Minute)
+		timeout, diags = v.Timeouts.Read(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
 	default:
 		tflog.Debug(ctx, fmt.Sprintf("operation not found : %v", operation))
-		return ctx, diags
-	}
-	if !diags.HasError() {
-		ctx, cancel := context.WithTimeout(ctx, timeout)
-		defer cancel()
-		return ctx, diags
-	}
-	return ctx, diags
-}
-
-func (client *NdfcClient) WaitForStatus(ctx context.Context, serial_number string, v VRF, expectedStatus string) string {
-	var CurrentStatus string
-	var diags diag.Diagnostics
-
-	for i := 0; i < helpers.NDFC_CHECK_STATUS_RETRIES; i++ {
-		time.Sleep(helpers.NDFC_CHECK_STATUS_DELAY * time.Second)
-		CurrentStatus, diags = client.ndfcGetAttachmentsPerVrf(ctx, v, serial_number)
-		if diags.HasError() {
-			tflog.Debug(ctx, fmt.Sprintf("ndfcGetAttachmentsPerVrf failed for VRF %v",
-				v.VrfName.ValueString()))
-			return CurrentStatus
-		}
-		log.Printf("WaitForStatus Akash status string %v", CurrentStatus)
-		tflog.Debug(ctx, fmt.Sprintf("WaitForStatus status: %v try: %v", CurrentStatus, i))
-		if strings.Contains(expectedStatus, CurrentStatus) {
-			return CurrentStatus
-		}
-
+		return ctx, func() {}, diags
 	}
-	return CurrentStatus
-}
-func (r *NdfcClient) checkStateStabilized(ctx context.Context, serial_number string, v VRF, expectedStatus string) string {
-	var CurrentStatus string
-	var diags diag.Diagnostics
-
-	for i := 0; i < helpers.NDFC_CHECK_STATUS_RETRIES; i++ {
-		time.Sleep(helpers.NDFC_CHECK_STATUS_DELAY * time.Second)
-		CurrentStatus, diags = r.ndfcGetAttachmentsPerVrf(ctx, v, serial_number)
-		if diags.HasError() {
-			tflog.Debug(ctx, fmt.Sprintf("ndfcGetAttachmentsPerVrf failed for VRF %v",
-				v.VrfName.ValueString()))
-			return CurrentStatus
-		}
-		log.Printf("checkStateStabilized Akash status string %v %v %v", CurrentStatus, expectedStatus, i)
-		tflog.Debug(ctx, fmt.Sprintf("checkExpectedState status: %v %v %v", CurrentStatus, expectedStatus, i))
-		if !strings.Contains(expectedStatus, CurrentStatus) {
-			return CurrentStatus
-		}
+	if diags.HasError() {
+		return ctx, func() {}, diags
 	}
-	return CurrentStatus
-}
-
-func (client *NdfcClient) Deploy(ctx context.Context, v VRF, serial_number string, expectedStatus string) (diag.Diagnostics, map[string]bool) {
-	var diags diag.Diagnostics
-	var CurrentStatus string
-	var res gjson.Result
-	not_deployed_list := make(map[string]bool)
-	var err error
-
-	NextValidState := "DEPLOYED OUT-OF-SYNC FAILED PENDING NA"
-	logit()
-	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Deploy", v.Id.ValueString()))
-	body := ""
-	body, _ = sjson.Set(body, serial_number, v.VrfName.ValueString())
-	log.Printf("Akash deploy body %v", body)
-	for i := 0; i < 2; i++ {
-		CurrentStatus, diags = client.ndfcGetAttachmentsPerVrf(ctx, v, serial_number)
-		if diags.HasError() {
-			tflog.Debug(ctx, fmt.Sprintf("ndfcGetAttachmentsPerVrf failed for VRF %v",
-				v.VrfName.ValueString()))
-			not_deployed_list[serial_number] = true
-			return diags, not_deployed_list
-		}
-		log.Printf("Akash CurrentStatus: %v serial_number %v", CurrentStatus, serial_number)
-		if strings.Contains(CurrentStatus, "IN PROGRESS") {
-			CurrentStatus = client.WaitForStatus(ctx, serial_number, v, NextValidState)
-			if !strings.Contains(NextValidState, CurrentStatus) {
-				diags.AddError("Client Error", fmt.Sprintf("unknown v: %v reached when trying to deploy",
-					CurrentStatus))
-				not_deployed_list[serial_number] = true
-				return diags, not_deployed_list
-			}
-		}
-		CurrentStatus = client.checkStateStabilized(ctx, serial_number, v, expectedStatus)
-		log.Printf("Akash CurrentStatus after IN PROGRESS: %v", CurrentStatus)
-		switch CurrentStatus {
-		case "DEPLOYED":
-			if expectedStatus == "DEPLOYED" {
-				return diags, not_deployed_list
-			}
-		case "NA":
-			if expectedStatus == "NA" {
-				return diags, not_deployed_list
-			}
-		case "OUT-OF-SYNC":
-			fallthrough
-		case "PENDING":
-			res, err, diags = client.ndfcRestApiRequest(ctx, "POST", "/lan-fabric/rest/top-down/vrfs/deploy", body)
-			if err != nil {
-				diags.AddError("Client Error", fmt.Sprintf("Failed to POST, got error: %s, %s", err, res.String()))
-				return diags, not_deployed_list
-			}
-		case "FAILED":
-			not_deployed_list[serial_number] = true
-			return diags, not_deployed_list
-		default:
-			diags.AddError("Client Error", fmt.Sprintf("Unknown state '%s' for serial number '%s' when trying to Deploy", CurrentStatus, serial_number))
-			return diags, not_deployed_list
-		}
-	}
-	NextValidState = "DEPLOYED OUT-OF-SYNC FAILED NA"
-
-	CurrentStatus = client.WaitForStatus(ctx, serial_number, v, NextValidState)
-	if !strings.Contains(NextValidState, CurrentStatus) {
-		diags.AddError("Client Error", fmt.Sprintf("Reached state %v which is not expected",
-			CurrentStatus))
-		not_deployed_list[serial_number] = true
-		return diags, not_deployed_list
-	}
-	if CurrentStatus == "FAILED" || CurrentStatus == "OUT-OF-SYNC" {
-		not_deployed_list[serial_number] = true
-		return diags, not_deployed_list
-	}
-
-	CurrentStatus = client.checkStateStabilized(ctx, serial_number, v, CurrentStatus)
-	if !strings.Contains(NextValidState, CurrentStatus) {
-		diags.AddError("Client Error", fmt.Sprintf("Reached state %v which is not expected",
-			CurrentStatus))
-		not_deployed_list[serial_number] = true
-		return diags, not_deployed_list
-	}
-	if CurrentStatus == "FAILED" || CurrentStatus == "OUT-OF-SYNC" {
-		not_deployed_list[serial_number] = true
-		return diags, not_deployed_list
-	}
-
-	if expectedStatus == "NA" {
-		if CurrentStatus == "NA" {
-			not_deployed_list[serial_number] = false
-			return diags, not_deployed_list
-		}
-	} else if expectedStatus == "DEPLOYED" {
-		if CurrentStatus == "DEPLOYED" {
-			not_deployed_list[serial_number] = false
-			return diags, not_deployed_list
-		}
-	}
-
-	tflog.Debug(ctx, fmt.Sprintf("Reached state '%v' which is not expected ", CurrentStatus))
-	not_deployed_list[serial_number] = true
-	return diags, not_deployed_list
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	return ctx, cancel, diags
 }
@@ -21,8 +21,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"reflect"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -30,38 +28,32 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/netascode/terraform-provider-ndfc/internal/provider/helpers"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
 	"github.com/tidwall/gjson"
 )
 
-func (client *NdfcClient) ndfcVrfCreate(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse, v *VRF) bool {
+func (client *VRFResource) ndfcVrfCreate(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse, v *VRF) bool {
 	var diags diag.Diagnostics
 	var res gjson.Result
 	var err error
 	logit()
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Create", v.Id.ValueString()))
 	body := v.toBody(ctx)
-	res, err, diags = client.ndfcRestApiRequest(ctx, "POST", v.getPath(), body)
+	_, err = client.client.Post(ctx, v.getPath(), body)
+	if ndfc.IsConflict(err) {
+		// if entity already exists, continue with operation
+		_, err = client.client.Put(ctx, fmt.Sprintf("%v%v", v.getPath(), v.VrfName.ValueString()), body)
+	}
 	if err != nil {
-		if strings.Contains(res.String(), "already exists") {
-			// if entity already exists, continue with operation
-			_, err, diags = client.ndfcRestApiRequest(ctx, "PUT", fmt.Sprintf("%v%v", v.getPath(), v.VrfName.ValueString()), body)
-			if err != nil {
-				if ndfcCheckDiags(diags, resp) {
-					tflog.Debug(ctx, fmt.Sprintf("Failed to post vrf %v path %v", v.getPath(), body))
-				}
-			}
-		} else {
-			if ndfcCheckDiags(diags, resp) {
-				tflog.Debug(ctx, fmt.Sprintf("Failed to post vrf %v path %v", v.getPath(), body))
-			}
-			return failed
-		}
+		tflog.Debug(ctx, fmt.Sprintf("Failed to post vrf %v path %v", v.getPath(), body))
+		resp.Diagnostics.Append(helpers.ClientError("Failed to configure object", err, v.fieldPaths()))
+		return failed
 	}
 
 	v.Id = types.StringValue(v.FabricName.ValueString() + "/" + v.VrfName.ValueString())
 	if len(v.Attachments) > 0 {
 		// deploy
-		diags = client.ndfcPerSwitchAttachmentAndDeploy(ctx, v, "DEPLOYED")
+		diags = client.ndfcPerSwitchAttachmentAndDeploy(ctx, v, ndfc.StateDeployed)
 		if ndfcCheckDiags(diags, resp) {
 			return failed
 		}
@@ -74,71 +66,66 @@ func (client *NdfcClient) ndfcVrfCreate(ctx context.Context, req resource.Create
 		return failed
 	}
 	v.fromBody(ctx, res)
+	diags = client.ndfcVrfReadAttachmentStatus(ctx, v)
+	if ndfcCheckDiags(diags, resp) {
+		return failed
+	}
 	tflog.Debug(ctx, fmt.Sprintf("%s: Create finished successfully", v.Id.ValueString()))
 	return success
 
 }
-func (client *NdfcClient) ndfcVrfRead(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse, v *VRF) bool {
+func (client *VRFResource) ndfcVrfRead(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse, v *VRF) bool {
 
 	logit()
-	res, err, _ := client.ndfcRestApiRequest(ctx, "GET", fmt.Sprintf("%v%v", v.getPath(), v.VrfName.ValueString()), "")
+	res, err, diags := client.ndfcRestApiRequest(ctx, "GET", fmt.Sprintf("%v%v", v.getPath(), v.VrfName.ValueString()), "")
 
 	if err != nil {
-		if strings.Contains(err.Error(), "StatusCode 400") || strings.Contains(err.Error(), "StatusCode 500") {
+		if ndfc.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return failed
 		}
+		ndfcCheckDiags(diags, resp)
+		return failed
 	}
 	v.fromBody(ctx, res)
-	res, err, diags := client.ndfcRestApiRequest(ctx, "GET", fmt.Sprintf("%vattachments?vrf-names=%v", v.getPath(), v.VrfName.ValueString()), "")
+	res, err, diags = client.ndfcRestApiRequest(ctx, "GET", fmt.Sprintf("%vattachments?vrf-names=%v", v.getPath(), v.VrfName.ValueString()), "")
 	if err != nil {
 		if ndfcCheckDiags(diags, resp) {
 			tflog.Debug(ctx, fmt.Sprintf("Failed to get vrf %v", v.VrfName.ValueString()))
 			return failed
 		}
 	}
-	v.fromBodyAttachments(ctx, res, false)
+	if v.Attachments != nil {
+		v.fromBodyManagedAttachments(ctx, res)
+	} else {
+		// attachments are not managed, e.g. by ndfc_vrf_attachment
+		v.AttachmentStatus = helpers.AttachmentStatus(res.Get("0"))
+	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", v.Id.ValueString()))
 	return success
 
 }
 
-func (client *NdfcClient) ndfcVrfUpdate(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse, v *VRF, delete_attachments bool) bool {
+func (client *VRFResource) ndfcVrfUpdate(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse, v *VRF, delete_attachments bool) bool {
 
-	//var Attachments gjson.Result
+	var diags diag.Diagnostics
 	body := v.toBody(ctx)
-	res, err, diags := client.ndfcRestApiRequest(ctx, "PUT", fmt.Sprintf("%v%v", v.getPath(), v.VrfName.ValueString()), body)
+	res, err := client.client.Put(ctx, fmt.Sprintf("%v%v", v.getPath(), v.VrfName.ValueString()), body)
 	if err != nil {
-		if ndfcCheckDiags(diags, resp) {
-			tflog.Debug(ctx, fmt.Sprintf("Failed to (PUT)object , got error: %s, %s", err, res.String()))
-			return failed
-		}
+		tflog.Debug(ctx, fmt.Sprintf("Failed to (PUT)object , got error: %s, %s", err, res.String()))
+		resp.Diagnostics.Append(helpers.ClientError("Failed to configure object (PUT)", err, v.fieldPaths()))
+		return failed
 	}
 
-	log.Printf("Akash delete_attachments v.attachments %v %v", delete_attachments, len(v.Attachments))
-	if !delete_attachments {
-		if len(v.Attachments) > 0 {
-			// deploy
-			diags = client.ndfcPerSwitchAttachmentAndDeploy(ctx, v, "DEPLOYED")
-			if ndfcCheckDiags(diags, resp) {
-				return failed
-			}
-		}
-	} else {
-		// Undeploy
-		Attachments, err, diags := client.ndfcRestApiRequest(ctx, "GET", fmt.Sprintf("%vattachments?vrf-names=%v", v.getPath(), v.VrfName.ValueString()), "")
-		if err != nil {
-			if ndfcCheckDiags(diags, resp) {
-				tflog.Debug(ctx, fmt.Sprintf("Failed to (GET)object , got error: %s, %s", err, res.String()))
-				return failed
-			}
+	if len(v.Attachments) > 0 {
+		desired_status := ndfc.StateDeployed
+		if delete_attachments {
+			desired_status = ndfc.StateNA
 		}
-		if len(Attachments.Get("0").Array()) > 0 {
-			diags = client.ndfcPerSwitchAttachmentAndDeploy(ctx, v, "NA")
-			if ndfcCheckDiags(diags, resp) {
-				return failed
-			}
+		diags = client.ndfcPerSwitchAttachmentAndDeploy(ctx, v, desired_status)
+		if ndfcCheckDiags(diags, resp) {
+			return failed
 		}
 	}
 
@@ -154,7 +141,7 @@ func (client *NdfcClient) ndfcVrfUpdate(ctx context.Context, req resource.Update
 
 }
 
-func (client *NdfcClient) ndfcVrfDelete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse, v *VRF) bool {
+func (client *VRFResource) ndfcVrfDelete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse, v *VRF) bool {
 	var diags diag.Diagnostics
 	var res gjson.Result
 	var err error
@@ -162,12 +149,16 @@ func (client *NdfcClient) ndfcVrfDelete(ctx context.Context, req resource.Delete
 	logit()
 	log.Printf("Akash Beggining Delete")
 	if len(v.Attachments) > 0 {
-		diags = client.ndfcPerSwitchAttachmentAndDeploy(ctx, v, "NA")
+		diags = client.ndfcPerSwitchAttachmentAndDeploy(ctx, v, ndfc.StateNA)
 		if ndfcCheckDiags(diags, resp) {
 			return failed
 		}
 	}
-	time.Sleep(5 * time.Second)
+	// give NDFC time to release the VRF after the last detach
+	if err = ndfc.Wait(ctx, 5*time.Second); err != nil {
+		resp.Diagnostics.Append(helpers.WaitError("Failed to delete object (DELETE)", err))
+		return failed
+	}
 	res, err, diags = client.ndfcRestApiRequest(ctx, "DELETE", fmt.Sprintf("%v%v", v.getPath(), v.VrfName.ValueString()), "")
 	if err != nil {
 		if ndfcCheckDiags(diags, resp) {
@@ -179,152 +170,160 @@ func (client *NdfcClient) ndfcVrfDelete(ctx context.Context, req resource.Delete
 	tflog.Debug(ctx, fmt.Sprintf("%s: Delete finished successfully", v.Id.ValueString()))
 	return success
 }
-func (client *NdfcClient) ndfcAttachSwitchToVrf(ctx context.Context, v *VRF, desired_status string) (string, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	var res gjson.Result
-	var serial_nos string
-	var forced_dettach bool
+
+// ndfcVrfReadAttachmentStatus sets the attachment_status of v from NDFC.
+func (client *VRFResource) ndfcVrfReadAttachmentStatus(ctx context.Context, v *VRF) diag.Diagnostics {
+	res, err, diags := client.ndfcRestApiRequest(ctx, "GET", fmt.Sprintf("%vattachments?vrf-names=%v", v.getPath(), v.VrfName.ValueString()), "")
+	if err != nil {
+		return diags
+	}
+	v.AttachmentStatus = helpers.FilterAttachmentStatus(helpers.AttachmentStatus(res.Get("0")), v.managedSerialNumbers())
+	return diags
+}
+
+// ndfcVrfRedeploy deploys the switches of the plan p with deploy_config
+// enabled whose state in s is not DEPLOYED, e.g. because they were changed
+// outside of Terraform.
+func (client *VRFResource) ndfcVrfRedeploy(ctx context.Context, resp *resource.UpdateResponse, p *VRF, s *VRF) bool {
+	var serial_nos []string
+	status := s.AttachmentStatus.Elements()
+	for _, item := range p.Attachments {
+		if !item.DeployConfig.ValueBool() {
+			continue
+		}
+		if v, ok := status[item.SerialNumber.ValueString()].(types.String); ok && v.ValueString() != ndfc.StateDeployed {
+			serial_nos = append(serial_nos, item.SerialNumber.ValueString())
+		}
+	}
+	if len(serial_nos) == 0 {
+		return success
+	}
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Deploy of %v", p.Id.ValueString(), serial_nos))
+	err := client.client.Deploy(ctx, ndfc.VRFType, p.FabricName.ValueString(), p.VrfName.ValueString(), serial_nos...)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.WaitError(fmt.Sprintf("Failed to deploy vrf %s", p.VrfName.ValueString()), err))
+		return failed
+	}
+	tflog.Debug(ctx, fmt.Sprintf("%s: Deploy finished successfully", p.Id.ValueString()))
+	return success
+}
+
+// ndfcAttachSwitchToVrf posts the attachments of v to NDFC. It returns the
+// switches to deploy, which are all attachments when detaching.
+func (client *VRFResource) ndfcAttachSwitchToVrf(ctx context.Context, v *VRF, desired_status string) ([]string, diag.Diagnostics) {
+	var serial_nos []string
+	forced_dettach := desired_status == ndfc.StateNA
 
 	Attachments, err, diags := client.ndfcRestApiRequest(ctx, "GET", fmt.Sprintf("%vattachments?vrf-names=%v", v.getPath(), v.VrfName.ValueString()), "")
 	if err != nil {
 		tflog.Debug(ctx, fmt.Sprintf("Failed to get attachments for vrf %v", v.VrfName.ValueString()))
 		return serial_nos, diags
 	}
-	log.Printf("Akash Attachments %v", Attachments)
-	Attachments.Get("0").ForEach(func(k, r gjson.Result) bool {
-		log.Printf("Akash r %v", r)
-		if desired_status == "NA" {
-			// if case of delete/destroy attachment needs to be forced detached
-			forced_dettach = true
-		} else {
-			forced_dettach = false
-		}
+	Attachments.Get("0.lanAttachList").ForEach(func(k, r gjson.Result) bool {
 		serial_number := r.Get("switchSerialNo").String()
 		for _, item := range v.Attachments {
-			log.Printf("Akash item.SerialNumber.ValueString() %v", item.SerialNumber.ValueString())
-			log.Printf("Akash serial_number %v", serial_number)
-			log.Printf("Akash forced_dettach %v", forced_dettach)
+			if item.SerialNumber.ValueString() != serial_number {
+				continue
+			}
 			bodyAttachments := v.toBodyAttachments(ctx, r, forced_dettach)
-			log.Printf("Akash bodyAttachments %v", bodyAttachments)
-			res, err, diags = client.ndfcRestApiRequest(ctx, "POST", v.getPath()+"attachments", bodyAttachments)
+			res, err := client.client.Post(ctx, v.getPath()+"attachments", bodyAttachments)
 			if err != nil {
-				diags.AddError("Client Error", fmt.Sprintf("Failed to perform attachments for vrf %v, got error: %s, %s", v.VrfName.ValueString(), err, res.String()))
-				tflog.Debug(ctx, fmt.Sprintf("Failed to post attachments for vrf %v", v.VrfName.ValueString()))
+				diags.Append(helpers.ClientError(fmt.Sprintf("Failed to perform attachments for vrf %v", v.VrfName.ValueString()), err, nil))
 				return false
 			}
 			diags = helpers.CheckAttachmentResponse(ctx, res)
 			if diags.HasError() {
 				tflog.Debug(ctx, fmt.Sprintf("ndfcCheckDiags failed  %v for CheckAttachmentResponse",
 					v.VrfName.ValueString()))
-				diags.AddError("Client Error", fmt.Sprintf("Failed to perform attachments for vrf %v, got error: %s, %s", v.VrfName.ValueString(), err, res.String()))
 				return false
 			}
-			if !item.DeployConfig.IsNull() && !item.DeployConfig.IsUnknown() && item.DeployConfig.ValueBool() {
-				serial_nos += item.SerialNumber.ValueString() + " "
-				log.Printf("Akash serial_no %v", serial_nos)
-			} else if forced_dettach {
-				serial_nos += item.SerialNumber.ValueString() + " "
-				log.Printf("Akash serial_no %v", serial_nos)
+			if forced_dettach || (!item.DeployConfig.IsNull() && !item.DeployConfig.IsUnknown() && item.DeployConfig.ValueBool()) {
+				serial_nos = append(serial_nos, serial_number)
 			}
 		}
 		return true
 	})
 	return serial_nos, diags
 }
-func (client *NdfcClient) ndfcPerSwitchAttachmentAndDeploy(ctx context.Context, v *VRF, desired_status string) diag.Diagnostics {
-
-	var res gjson.Result
-	var CurrentStatus string
-	not_deployed_list := make(map[string]bool)
-	var diags diag.Diagnostics
 
+// ndfcPerSwitchAttachmentAndDeploy attaches v to its switches, or detaches it
+// if desired_status is NA, and deploys the change.
+func (client *VRFResource) ndfcPerSwitchAttachmentAndDeploy(ctx context.Context, v *VRF, desired_status string) diag.Diagnostics {
 	serial_nos, diags := client.ndfcAttachSwitchToVrf(ctx, v, desired_status)
-	if diags.HasError() {
-		tflog.Debug(ctx, fmt.Sprintf("ndfcGetAttachmentsPerVrf failed for VRF %v",
-			v.VrfName.ValueString()))
+	if diags.HasError() || len(serial_nos) == 0 {
 		return diags
 	}
-	serial_number := strings.Fields(serial_nos)
-	log.Printf("Akash serial number in list: %v", serial_number)
-	if len(serial_number) > 0 {
-		for _, item := range serial_number {
-			CurrentStatus, diags = client.ndfcGetAttachmentsPerVrf(ctx, *v, item)
-			if diags.HasError() {
-				tflog.Debug(ctx, fmt.Sprintf("ndfcGetAttachmentsPerVrf failed for VRF %v",
-					v.VrfName.ValueString()))
-				return diags
-			}
-			log.Printf("Akash serial number in list: %v", item)
-			if CurrentStatus != "DEPLOYED" {
-				diags, not_deployed_list = client.Deploy(ctx, *v, item, desired_status)
-				if diags.HasError() {
-					tflog.Debug(ctx, fmt.Sprintf("ndfcCheckDiags failed  %v for CheckAttachmentResponse",
-						v.VrfName.ValueString()))
-					return diags
-				}
-			}
-		}
-	}
-	serial_nos = ""
-	for index, item := range not_deployed_list {
-		if item {
-			serial_nos += index + " "
-		}
-	}
-	if len(serial_nos) > 0 {
-		diags.AddError("Client Error", fmt.Sprintf("Failed to deploy config for switch %s for vrf %s, got error: %s", serial_nos, v.VrfName.ValueString(), res.Get("0.message").String()))
-	}
-	return diags
-}
-func (client *NdfcClient) ndfcGetAttachmentsPerVrf(ctx context.Context, v VRF, serial_number string) (string, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	var res gjson.Result
-	var err error
-	var status string
-	Attachments, err, diags := client.ndfcRestApiRequest(ctx, "GET", fmt.Sprintf("%vattachments?vrf-names=%v", v.getPath(), v.VrfName.ValueString()), "")
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Deploy", v.Id.ValueString()))
+	err := client.client.Deploy(ctx, ndfc.VRFType, v.FabricName.ValueString(), v.VrfName.ValueString(), serial_nos...)
 	if err != nil {
-		diags.AddError("Client Error", fmt.Sprintf("Failed to retrieve VRFs, got error: %s, %s", err, res.String()))
-		log.Printf("Akash status %v", status)
-		return status, diags
+		diags.Append(helpers.WaitError(fmt.Sprintf("Failed to deploy vrf %s", v.VrfName.ValueString()), err))
+		return diags
 	}
-	Attachments.Get("0").ForEach(func(k, r gjson.Result) bool {
-		cur_serial_number := r.Get("switchSerialNo").String()
-		log.Printf("Akash cur_serial_number %v ", cur_serial_number)
-		if serial_number == cur_serial_number {
-			status = r.Get("lanAttachState").String()
-			log.Printf("Akash status %v", status)
-			return false
-		}
-		return true
-	})
-	return status, diags
+	tflog.Debug(ctx, fmt.Sprintf("%s: Deploy finished successfully", v.Id.ValueString()))
+	return diags
 }
-func (client *NdfcClient) ndfcCompareVrfAttachments(p VRF, s VRF) ([]VRFAttachments, []VRFAttachments) {
+
+// ndfcCompareVrfAttachments compares the attachments of the plan p and the
+// state s by serial number. It returns the attachments of p that are new or
+// changed, which are updated in place, and the attachments of s whose switch
+// is no longer in p, which are detached. Nothing is returned if p does not
+// set any attachments.
+func (client *VRFResource) ndfcCompareVrfAttachments(p VRF, s VRF) ([]VRFAttachments, []VRFAttachments) {
 	var TempAdd, TempDel []VRFAttachments
-	var is_equal bool
+	if p.Attachments == nil {
+		// attachments are not managed
+		return TempAdd, TempDel
+	}
+	current := make(map[string]VRFAttachments, len(s.Attachments))
+	for _, s_value := range s.Attachments {
+		current[s_value.SerialNumber.ValueString()] = s_value
+	}
 	for _, p_value := range p.Attachments {
-		is_equal = false
-		for _, s_value := range s.Attachments {
-			is_equal = reflect.DeepEqual(p_value, s_value)
-			if is_equal {
-				break
-			}
-		}
-		if !is_equal {
+		s_value, ok := current[p_value.SerialNumber.ValueString()]
+		delete(current, p_value.SerialNumber.ValueString())
+		if !ok || !ndfcEqualVrfAttachment(p_value, s_value) {
 			TempAdd = append(TempAdd, p_value)
 		}
 	}
-	for _, s_value := range p.Attachments {
-		is_equal = false
-		for _, p_value := range s.Attachments {
-			is_equal = reflect.DeepEqual(p_value, s_value)
-			if is_equal {
-				break
-			}
-		}
-		if !is_equal {
+	for _, s_value := range s.Attachments {
+		if _, ok := current[s_value.SerialNumber.ValueString()]; ok {
 			TempDel = append(TempDel, s_value)
 		}
 	}
 	return TempAdd, TempDel
 }
+
+// ndfcEqualVrfAttachment reports whether the attachments p and s are the
+// same, comparing the values of their attributes.
+func ndfcEqualVrfAttachment(p, s VRFAttachments) bool {
+	return p.SerialNumber.Equal(s.SerialNumber) &&
+		p.DeployConfig.Equal(s.DeployConfig) &&
+		p.VlanId.Equal(s.VlanId) &&
+		p.FreeformConfig.Equal(s.FreeformConfig) &&
+		p.LoopbackId.Equal(s.LoopbackId) &&
+		p.LoopbackIpv4.Equal(s.LoopbackIpv4) &&
+		p.LoopbackIpv6.Equal(s.LoopbackIpv6) &&
+		ndfcEqualVrfLite(p.VrfLite, s.VrfLite)
+}
+
+// ndfcEqualVrfLite reports whether the VRF Lite connections p and s are the
+// same, in the same order.
+func ndfcEqualVrfLite(p, s []VRFAttachmentsVrfLite) bool {
+	if len(p) != len(s) {
+		return false
+	}
+	for i := range p {
+		if !p[i].InterfaceName.Equal(s[i].InterfaceName) ||
+			!p[i].Dot1qId.Equal(s[i].Dot1qId) ||
+			!p[i].Ipv4Address.Equal(s[i].Ipv4Address) ||
+			!p[i].NeighborIpv4.Equal(s[i].NeighborIpv4) ||
+			!p[i].NeighborAsn.Equal(s[i].NeighborAsn) ||
+			!p[i].Ipv6Address.Equal(s[i].Ipv6Address) ||
+			!p[i].NeighborIpv6.Equal(s[i].NeighborIpv6) ||
+			!p[i].PeerVrfName.Equal(s[i].PeerVrfName) ||
+			!p[i].AutoVrfLite.Equal(s[i].AutoVrfLite) {
+			return false
+		}
+	}
+	return true
+}
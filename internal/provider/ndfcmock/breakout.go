@@ -0,0 +1,122 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package ndfcmock
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+var breakoutMap = regexp.MustCompile(`^\d+g-(\d)x$`)
+
+// breakout is a physical interface split into child interfaces. The switch
+// only reflects a breakout, or its removal, once it is deployed.
+type breakout struct {
+	count    int
+	pending  bool
+	removing bool
+}
+
+// brokenOut reports whether the switch has the child interfaces instead of
+// the parent.
+func (b *breakout) brokenOut() bool {
+	return b != nil && (!b.pending || b.removing)
+}
+
+// physicalExists reports whether a physical interface exists on a switch,
+// taking breakouts into account. Children are named after their parent,
+// e.g. Ethernet1/49/1.
+func (s *Server) physicalExists(serialNumber, ifName string) bool {
+	parts := strings.Split(ifName, "/")
+	switch len(parts) {
+	case 1:
+		return true
+	case 2:
+		return !s.breakouts[interfaceKey(serialNumber, ifName)].brokenOut()
+	case 3:
+		b := s.breakouts[interfaceKey(serialNumber, parts[0]+"/"+parts[1])]
+		n, err := strconv.Atoi(parts[2])
+		return b.brokenOut() && err == nil && n >= 1 && n <= b.count
+	}
+	return false
+}
+
+func (s *Server) breakoutInterfaces(body gjson.Result) (interface{}, *apiError) {
+	for _, e := range body.Array() {
+		serialNumber := e.Get("serialNumber").String()
+		ifName := e.Get("ifName").String()
+		if _, ok := s.switches[serialNumber]; !ok {
+			return nil, errorf(http.StatusBadRequest, "Switch with serial number %s not found", serialNumber)
+		}
+		m := breakoutMap.FindStringSubmatch(e.Get("map").String())
+		if m == nil {
+			return nil, errorf(http.StatusBadRequest, "Invalid breakout map %q", e.Get("map").String())
+		}
+		if strings.Count(ifName, "/") != 1 || !isPhysical(ifName) {
+			return nil, errorf(http.StatusBadRequest, "Interface %s cannot be broken out", ifName)
+		}
+		key := interfaceKey(serialNumber, ifName)
+		if _, ok := s.breakouts[key]; ok {
+			return nil, errorf(http.StatusBadRequest, "Interface %s on switch %s is already broken out", ifName, serialNumber)
+		}
+		count, _ := strconv.Atoi(m[1])
+		s.breakouts[key] = &breakout{count: count, pending: true}
+		delete(s.interfaces, key)
+	}
+	return []interface{}{}, nil
+}
+
+func (s *Server) unbreakoutInterfaces(body gjson.Result) (interface{}, *apiError) {
+	for _, e := range body.Array() {
+		serialNumber := e.Get("serialNumber").String()
+		ifName := e.Get("ifName").String()
+		key := interfaceKey(serialNumber, ifName)
+		b, ok := s.breakouts[key]
+		if !ok || b.removing {
+			return nil, errorf(http.StatusBadRequest, "Interface %s on switch %s is not broken out", ifName, serialNumber)
+		}
+		if !b.brokenOut() {
+			delete(s.breakouts, key)
+			continue
+		}
+		b.removing, b.pending = true, true
+		for n := 1; n <= b.count; n++ {
+			delete(s.interfaces, interfaceKey(serialNumber, fmt.Sprintf("%s/%d", ifName, n)))
+		}
+	}
+	return []interface{}{}, nil
+}
+
+// deployBreakouts applies the pending breakouts of a switch.
+func (s *Server) deployBreakouts(serialNumber string) {
+	for key, b := range s.breakouts {
+		if !strings.HasPrefix(key, serialNumber+"/") || !b.pending {
+			continue
+		}
+		if b.removing {
+			delete(s.breakouts, key)
+		} else {
+			b.pending = false
+		}
+	}
+}
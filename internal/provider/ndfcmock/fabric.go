@@ -0,0 +1,173 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package ndfcmock
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+const fabricTemplate = "Easy_Fabric"
+
+// fabricDefaults are the Easy_Fabric parameters NDFC fills in when they are
+// not part of the request.
+var fabricDefaults = map[string]string{
+	"LINK_STATE_ROUTING":    "ospf",
+	"REPLICATION_MODE":      "Multicast",
+	"ANYCAST_GW_MAC":        "2020.0000.00aa",
+	"VRF_VLAN_RANGE":        "2000-2299",
+	"NETWORK_VLAN_RANGE":    "2300-2999",
+	"L2_SEGMENT_ID_RANGE":   "30000-49000",
+	"L3_PARTITION_ID_RANGE": "50000-59000",
+}
+
+type fabric struct {
+	name     string
+	template string
+	nvPairs  map[string]string
+}
+
+func newFabric(name string, params gjson.Result) *fabric {
+	f := &fabric{name: name, template: fabricTemplate, nvPairs: map[string]string{}}
+	for k, v := range fabricDefaults {
+		f.nvPairs[k] = v
+	}
+	params.ForEach(func(k, v gjson.Result) bool {
+		f.nvPairs[k.String()] = v.String()
+		return true
+	})
+	f.nvPairs["FABRIC_NAME"] = name
+	return f
+}
+
+func (f *fabric) toJSON() map[string]interface{} {
+	return map[string]interface{}{
+		"fabricName":   f.name,
+		"templateName": f.template,
+		"fabricType":   "Switch_Fabric",
+		"asn":          f.nvPairs["BGP_AS"],
+		"nvPairs":      f.nvPairs,
+	}
+}
+
+func (s *Server) routeFabric(req request) (interface{}, *apiError) {
+	seg := req.segments[4:]
+	switch {
+	case len(seg) == 0 && req.method == http.MethodGet:
+		return s.listFabrics(), nil
+	case len(seg) == 1 && req.method == http.MethodGet:
+		f, err := s.getFabric(seg[0])
+		if err != nil {
+			return nil, err
+		}
+		return f.toJSON(), nil
+	case len(seg) == 1 && req.method == http.MethodDelete:
+		return s.deleteFabric(seg[0])
+	case len(seg) >= 2 && inventoryRoutes[seg[1]]:
+		if _, err := s.getFabric(seg[0]); err != nil {
+			return nil, err
+		}
+		return s.routeInventory(seg[0], seg[1:], req)
+	case len(seg) == 2 && req.method == http.MethodPost:
+		return s.createFabric(seg[0], seg[1], req.body)
+	case len(seg) == 2 && req.method == http.MethodPut:
+		return s.updateFabric(seg[0], seg[1], req.body)
+	}
+	return nil, errorf(http.StatusNotFound, "No handler found for %s /%s", req.method, strings.Join(req.segments, "/"))
+}
+
+func (s *Server) getFabric(name string) (*fabric, *apiError) {
+	f, ok := s.fabrics[name]
+	if !ok {
+		return nil, errorf(http.StatusNotFound, "Fabric %s not found", name)
+	}
+	return f, nil
+}
+
+func (s *Server) listFabrics() []interface{} {
+	names := make([]string, 0, len(s.fabrics))
+	for name := range s.fabrics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	res := []interface{}{}
+	for _, name := range names {
+		res = append(res, s.fabrics[name].toJSON())
+	}
+	return res
+}
+
+func validateFabricParams(template string, params gjson.Result) *apiError {
+	if template != fabricTemplate {
+		return errorf(http.StatusBadRequest, "Template %s is not supported", template)
+	}
+	if !params.IsObject() {
+		return errorf(http.StatusBadRequest, "Invalid payload")
+	}
+	if params.Get("BGP_AS").String() == "" {
+		return errorf(http.StatusBadRequest, "BGP_AS is mandatory")
+	}
+	return nil
+}
+
+func (s *Server) createFabric(name, template string, params gjson.Result) (interface{}, *apiError) {
+	if _, ok := s.fabrics[name]; ok {
+		return nil, errorf(http.StatusBadRequest, "Fabric %s already exists", name)
+	}
+	if err := validateFabricParams(template, params); err != nil {
+		return nil, err
+	}
+	f := newFabric(name, params)
+	s.fabrics[name] = f
+	return f.toJSON(), nil
+}
+
+func (s *Server) updateFabric(name, template string, params gjson.Result) (interface{}, *apiError) {
+	if _, err := s.getFabric(name); err != nil {
+		return nil, err
+	}
+	if err := validateFabricParams(template, params); err != nil {
+		return nil, err
+	}
+	f := newFabric(name, params)
+	s.fabrics[name] = f
+	return f.toJSON(), nil
+}
+
+func (s *Server) deleteFabric(name string) (interface{}, *apiError) {
+	if _, err := s.getFabric(name); err != nil {
+		return nil, err
+	}
+	for serial, sw := range s.switches {
+		if sw.Fabric == name {
+			return nil, errorf(http.StatusBadRequest, "Fabric %s is in use by switch %s, remove all switches before deleting", name, serial)
+		}
+	}
+	for _, objects := range []map[string]*topDownObject{s.vrfs, s.networks} {
+		for _, o := range objects {
+			if o.fabric == name {
+				return nil, errorf(http.StatusBadRequest, "Fabric %s is in use by %s %s", name, strings.TrimSuffix(o.kind.nameField, "Name"), o.name)
+			}
+		}
+	}
+	delete(s.fabrics, name)
+	return []interface{}{}, nil
+}
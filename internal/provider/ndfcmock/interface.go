@@ -0,0 +1,419 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package ndfcmock
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+const defaultEthernetPolicy = "int_trunk_host"
+
+// ethernetPorts is the number of front panel ports of every switch.
+const ethernetPorts = 64
+
+type iface struct {
+	serialNumber string
+	ifName       string
+	policy       string
+	nvPairs      map[string]string
+	deployed     bool
+}
+
+func interfaceKey(serialNumber, ifName string) string {
+	return serialNumber + "/" + strings.ToLower(ifName)
+}
+
+// interfaceSwitches returns the switches an interface of serialNumber is
+// configured on. vPC interfaces are addressed by the serial numbers of both
+// peers, separated by "~".
+func (s *Server) interfaceSwitches(serialNumber string) ([]*Switch, *apiError) {
+	var res []*Switch
+	for _, serial := range strings.Split(serialNumber, "~") {
+		sw, ok := s.switches[serial]
+		if !ok {
+			return nil, errorf(http.StatusBadRequest, "Switch with serial number %s not found", serial)
+		}
+		res = append(res, sw)
+	}
+	if len(res) == 2 && res[0].vpcPeer != res[1].SerialNumber {
+		return nil, errorf(http.StatusBadRequest, "Switches %s and %s are not a vPC pair", res[0].SerialNumber, res[1].SerialNumber)
+	}
+	if len(res) > 2 {
+		return nil, errorf(http.StatusBadRequest, "Invalid serial number %s", serialNumber)
+	}
+	return res, nil
+}
+
+// isPhysical reports whether the interface always exists on a switch and can
+// therefore be modified but never created or deleted. Subinterfaces such as
+// Ethernet1/10.100 are logical interfaces. The NVE interface is created with
+// the fabric and treated like a physical one.
+func isPhysical(ifName string) bool {
+	name := strings.ToLower(ifName)
+	return strings.HasPrefix(name, "ethernet") && !strings.Contains(name, ".") || name == "nve1"
+}
+
+// defaultInterface returns a physical interface that was never modified.
+func defaultInterface(serialNumber, ifName string) *iface {
+	i := &iface{
+		serialNumber: serialNumber,
+		ifName:       ifName,
+		policy:       defaultEthernetPolicy,
+		nvPairs:      map[string]string{"INTF_NAME": ifName, "ADMIN_STATE": "true"},
+		deployed:     true,
+	}
+	if strings.EqualFold(ifName, "nve1") {
+		i.policy = "nve"
+		i.nvPairs["SOURCE_INTF_NAME"] = "loopback1"
+		i.nvPairs["HOST_REACHABILITY"] = "bgp"
+	}
+	return i
+}
+
+func (i *iface) toJSON(fabric string) map[string]interface{} {
+	return map[string]interface{}{
+		"policy": i.policy,
+		"interfaces": []interface{}{
+			map[string]interface{}{
+				"serialNumber":     i.serialNumber,
+				"ifName":           i.ifName,
+				"fabricName":       fabric,
+				"nvPairs":          i.nvPairs,
+				"complianceStatus": complianceStatus(i.deployed),
+			},
+		},
+	}
+}
+
+func complianceStatus(deployed bool) string {
+	if deployed {
+		return "In-Sync"
+	}
+	return "Pending"
+}
+
+func (s *Server) routeInterface(req request) (interface{}, *apiError) {
+	seg := req.segments[3:]
+	switch {
+	case len(seg) == 0 && req.method == http.MethodGet:
+		return s.getInterfaces(req.query("serialNumber"), req.query("ifName"))
+	case len(seg) == 0 && req.method == http.MethodPost:
+		return s.putInterfaces(req.body, true)
+	case len(seg) == 0 && req.method == http.MethodPut:
+		return s.putInterfaces(req.body, false)
+	case len(seg) == 0 && req.method == http.MethodDelete:
+		return s.deleteInterfaces(req.body)
+	case len(seg) == 1 && seg[0] == "detail" && req.method == http.MethodGet:
+		return s.getInterfaceDetails(req.query("serialNumber"), req.query("fabricName"))
+	case len(seg) == 1 && seg[0] == "deploy" && req.method == http.MethodPost:
+		return s.deployInterfaces(req.body)
+	case len(seg) == 1 && seg[0] == "breakout" && req.method == http.MethodPost:
+		return s.breakoutInterfaces(req.body)
+	case len(seg) == 1 && seg[0] == "breakout" && req.method == http.MethodDelete:
+		return s.unbreakoutInterfaces(req.body)
+	}
+	return nil, errorf(http.StatusNotFound, "No handler found for %s %s", req.method, strings.Join(req.segments, "/"))
+}
+
+// lookupInterface returns the stored interface, or a default one for
+// physical interfaces that were never modified.
+func (s *Server) lookupInterface(serialNumber, ifName string) (*iface, *apiError) {
+	if _, err := s.interfaceSwitches(serialNumber); err != nil {
+		return nil, err
+	}
+	if i, ok := s.interfaces[interfaceKey(serialNumber, ifName)]; ok {
+		return i, nil
+	}
+	if isPhysical(ifName) && s.physicalExists(serialNumber, ifName) {
+		return defaultInterface(serialNumber, ifName), nil
+	}
+	return nil, errorf(http.StatusBadRequest, "Interface %s does not exist on switch %s", ifName, serialNumber)
+}
+
+func (s *Server) getInterfaces(serialNumber, ifName string) (interface{}, *apiError) {
+	res := []interface{}{}
+	if ifName != "" {
+		i, err := s.lookupInterface(serialNumber, ifName)
+		if err != nil {
+			return nil, err
+		}
+		return append(res, i.toJSON(s.interfaceFabric(i))), nil
+	}
+	keys := make([]string, 0, len(s.interfaces))
+	for k, i := range s.interfaces {
+		if serialNumber == "" || contains(strings.Split(i.serialNumber, "~"), serialNumber) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		i := s.interfaces[k]
+		res = append(res, i.toJSON(s.interfaceFabric(i)))
+	}
+	return res, nil
+}
+
+// interfaceType returns the NDFC interface type of an interface.
+func (i *iface) interfaceType() string {
+	name := strings.ToLower(i.ifName)
+	switch {
+	case strings.Contains(i.serialNumber, "~"):
+		return "INTERFACE_VPC"
+	case strings.HasPrefix(name, "ethernet") && strings.Contains(name, "."):
+		return "SUBINTERFACE"
+	case strings.HasPrefix(name, "ethernet"):
+		return "INTERFACE_ETHERNET"
+	case strings.HasPrefix(name, "port-channel"):
+		return "INTERFACE_PORT_CHANNEL"
+	case strings.HasPrefix(name, "loopback"):
+		return "INTERFACE_LOOPBACK"
+	case strings.HasPrefix(name, "vlan"):
+		return "INTERFACE_VLAN"
+	case strings.HasPrefix(name, "nve"):
+		return "INTERFACE_NVE"
+	}
+	return "INTERFACE_UNKNOWN"
+}
+
+// toDetailJSON returns the operational view of the interface. The
+// operational state follows the admin state.
+func (i *iface) toDetailJSON(fabric string) map[string]interface{} {
+	state := "up"
+	if i.nvPairs["ADMIN_STATE"] == "false" {
+		state = "down"
+	}
+	vlans := i.nvPairs["ALLOWED_VLANS"]
+	if v, ok := i.nvPairs["ACCESS_VLAN"]; ok {
+		vlans = v
+	}
+	return map[string]interface{}{
+		"serialNo":         i.serialNumber,
+		"ifName":           i.ifName,
+		"ifType":           i.interfaceType(),
+		"fabricName":       fabric,
+		"underlayPolicies": []interface{}{map[string]interface{}{"templateName": i.policy}},
+		"adminStatusStr":   state,
+		"operStatusStr":    state,
+		"alias":            i.nvPairs["DESC"],
+		"vrf":              i.nvPairs["INTF_VRF"],
+		"allowedVLANs":     vlans,
+		"nativeVlanId":     i.nvPairs["NATIVE_VLAN"],
+	}
+}
+
+// switchInterfaces returns all interfaces of a switch: its physical ports,
+// taking breakouts into account, the NVE interface and all configured
+// interfaces, including vPCs the switch is part of.
+func (s *Server) switchInterfaces(serialNumber string) []*iface {
+	all := map[string]*iface{}
+	add := func(ifName string) {
+		all[interfaceKey(serialNumber, ifName)] = defaultInterface(serialNumber, ifName)
+	}
+	for n := 1; n <= ethernetPorts; n++ {
+		ifName := fmt.Sprintf("Ethernet1/%d", n)
+		if b := s.breakouts[interfaceKey(serialNumber, ifName)]; b.brokenOut() {
+			for c := 1; c <= b.count; c++ {
+				add(fmt.Sprintf("%s/%d", ifName, c))
+			}
+		} else {
+			add(ifName)
+		}
+	}
+	add("nve1")
+	for key, i := range s.interfaces {
+		if contains(strings.Split(i.serialNumber, "~"), serialNumber) {
+			all[key] = i
+		}
+	}
+	keys := make([]string, 0, len(all))
+	for k := range all {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	res := make([]*iface, len(keys))
+	for n, k := range keys {
+		res[n] = all[k]
+	}
+	return res
+}
+
+// getInterfaceDetails returns the operational view of all interfaces of a
+// switch, or of all switches of a fabric.
+func (s *Server) getInterfaceDetails(serialNumber, fabric string) (interface{}, *apiError) {
+	var serials []string
+	switch {
+	case serialNumber != "":
+		if _, ok := s.switches[serialNumber]; !ok {
+			return nil, errorf(http.StatusBadRequest, "Switch with serial number %s not found", serialNumber)
+		}
+		serials = append(serials, serialNumber)
+	case fabric != "":
+		if _, ok := s.fabrics[fabric]; !ok {
+			return nil, errorf(http.StatusNotFound, "Fabric %s not found", fabric)
+		}
+		for serial, sw := range s.switches {
+			if sw.Fabric == fabric {
+				serials = append(serials, serial)
+			}
+		}
+		sort.Strings(serials)
+	default:
+		return nil, errorf(http.StatusBadRequest, "Either serialNumber or fabricName is required")
+	}
+	res := []interface{}{}
+	seen := map[*iface]bool{}
+	for _, serial := range serials {
+		for _, i := range s.switchInterfaces(serial) {
+			// vPCs are listed once per fabric
+			if !seen[i] {
+				seen[i] = true
+				res = append(res, i.toDetailJSON(s.switches[serial].Fabric))
+			}
+		}
+	}
+	return res, nil
+}
+
+func (s *Server) interfaceFabric(i *iface) string {
+	return s.switches[strings.Split(i.serialNumber, "~")[0]].Fabric
+}
+
+// putInterfaces handles both POST (create logical interfaces) and PUT
+// (modify existing interfaces) requests. Every entry of "interfaces" is
+// applied, which allows bulk updates in a single call.
+func (s *Server) putInterfaces(body gjson.Result, create bool) (interface{}, *apiError) {
+	policy := body.Get("policy").String()
+	entries := body.Get("interfaces").Array()
+	if len(entries) == 0 {
+		return nil, errorf(http.StatusBadRequest, "Invalid payload: no interfaces specified")
+	}
+	for _, e := range entries {
+		serialNumber := e.Get("serialNumber").String()
+		ifName := e.Get("ifName").String()
+		if _, err := s.interfaceSwitches(serialNumber); err != nil {
+			return nil, err
+		}
+		existing, ok := s.interfaces[interfaceKey(serialNumber, ifName)]
+		if create && ok {
+			return nil, errorf(http.StatusBadRequest, "Interface %s already exists on switch %s", ifName, serialNumber)
+		}
+		if !create && !ok && !(isPhysical(ifName) && s.physicalExists(serialNumber, ifName)) {
+			return nil, errorf(http.StatusBadRequest, "Interface %s does not exist on switch %s", ifName, serialNumber)
+		}
+		i := &iface{serialNumber: serialNumber, ifName: ifName, policy: policy, nvPairs: map[string]string{}}
+		if i.policy == "" {
+			i.policy = defaultEthernetPolicy
+			if existing != nil {
+				i.policy = existing.policy
+			}
+		}
+		e.Get("nvPairs").ForEach(func(k, v gjson.Result) bool {
+			i.nvPairs[k.String()] = v.String()
+			return true
+		})
+		if err := s.checkMembers(i); err != nil {
+			return nil, err
+		}
+		s.interfaces[interfaceKey(serialNumber, ifName)] = i
+	}
+	return []interface{}{}, nil
+}
+
+// members returns the member interfaces of a port-channel or vPC, keyed by
+// the serial number of the switch they belong to.
+func (i *iface) members() map[string][]string {
+	res := map[string][]string{}
+	add := func(serialNumber, value string) {
+		for _, m := range strings.Split(value, ",") {
+			if m = strings.TrimSpace(m); m != "" {
+				res[serialNumber] = append(res[serialNumber], strings.ToLower(m))
+			}
+		}
+	}
+	serials := strings.Split(i.serialNumber, "~")
+	if len(serials) == 2 {
+		add(serials[0], i.nvPairs["PEER1_MEMBER_INTERFACES"])
+		add(serials[1], i.nvPairs["PEER2_MEMBER_INTERFACES"])
+	} else {
+		add(i.serialNumber, i.nvPairs["MEMBER_INTERFACES"])
+	}
+	return res
+}
+
+// checkMembers rejects member interfaces that already belong to another
+// port-channel or vPC of the switch.
+func (s *Server) checkMembers(i *iface) *apiError {
+	for key, other := range s.interfaces {
+		if key == interfaceKey(i.serialNumber, i.ifName) {
+			continue
+		}
+		used := other.members()
+		for serialNumber, members := range i.members() {
+			for _, m := range members {
+				if contains(used[serialNumber], m) {
+					return errorf(http.StatusBadRequest, "Interface %s of switch %s is already a member of %s", m, serialNumber, other.ifName)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Server) deleteInterfaces(body gjson.Result) (interface{}, *apiError) {
+	for _, e := range body.Array() {
+		serialNumber := e.Get("serialNumber").String()
+		ifName := e.Get("ifName").String()
+		if isPhysical(ifName) {
+			return nil, errorf(http.StatusBadRequest, "Physical interface %s cannot be deleted", ifName)
+		}
+		if _, err := s.lookupInterface(serialNumber, ifName); err != nil {
+			return nil, err
+		}
+		delete(s.interfaces, interfaceKey(serialNumber, ifName))
+	}
+	return []interface{}{}, nil
+}
+
+func (s *Server) deployInterfaces(body gjson.Result) (interface{}, *apiError) {
+	for _, e := range body.Array() {
+		serialNumber := e.Get("serialNumber").String()
+		ifName := e.Get("ifName").String()
+		if _, err := s.interfaceSwitches(serialNumber); err != nil {
+			return nil, err
+		}
+		// Deleted logical interfaces are deployed to remove their config.
+		if i, ok := s.interfaces[interfaceKey(serialNumber, ifName)]; ok {
+			i.deployed = true
+		}
+	}
+	return []interface{}{}, nil
+}
+
+func contains(s []string, str string) bool {
+	for _, v := range s {
+		if v == str {
+			return true
+		}
+	}
+	return false
+}
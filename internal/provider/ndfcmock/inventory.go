@@ -0,0 +1,251 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package ndfcmock
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+// inventoryRoutes are the path segments below a fabric handled by
+// routeInventory.
+var inventoryRoutes = map[string]bool{
+	"inventory":      true,
+	"switches":       true,
+	"config-save":    true,
+	"config-deploy":  true,
+	"config-preview": true,
+}
+
+var switchRoles = map[string]bool{
+	"leaf":                       true,
+	"spine":                      true,
+	"border":                     true,
+	"border gateway":             true,
+	"border spine":               true,
+	"border gateway spine":       true,
+	"super spine":                true,
+	"border super spine":         true,
+	"border gateway super spine": true,
+}
+
+// toJSON returns the inventory entry of the switch, advancing an ongoing
+// discovery by one step.
+func (sw *Switch) toJSON() map[string]interface{} {
+	status := "ok"
+	if sw.polls > 0 {
+		sw.polls--
+		status = "Discovering"
+	}
+	mode := "Normal"
+	if sw.migrating {
+		mode = "Migration"
+	}
+	return map[string]interface{}{
+		"serialNumber":     sw.SerialNumber,
+		"ipAddress":        sw.IpAddress,
+		"logicalName":      sw.Hostname,
+		"switchRole":       sw.Role,
+		"model":            sw.Model,
+		"release":          sw.Version,
+		"fabricName":       sw.Fabric,
+		"mode":             mode,
+		"status":           status,
+		"managable":        status == "ok",
+		"isVpcConfigured":  sw.vpcPeer != "",
+		"vpcDomain":        sw.vpcDomain,
+		"peerSerialNumber": sw.vpcPeer,
+	}
+}
+
+func (s *Server) routeInventory(fabric string, seg []string, req request) (interface{}, *apiError) {
+	switch {
+	case match(seg, "inventory", "switchesByFabric") && req.method == http.MethodGet:
+		return s.listSwitches(fabric), nil
+	case match(seg, "inventory", "test-reachability") && req.method == http.MethodPost:
+		return s.testReachability(req.body)
+	case match(seg, "inventory", "discover") && req.method == http.MethodPost:
+		return s.discover(fabric, req.body)
+	case len(seg) == 2 && seg[0] == "switches" && req.method == http.MethodDelete:
+		return s.removeSwitch(fabric, seg[1])
+	case len(seg) == 1 && seg[0] == "config-save" && req.method == http.MethodPost:
+		return map[string]interface{}{"status": "Config save is completed"}, nil
+	case len(seg) == 1 && seg[0] == "config-deploy" && req.method == http.MethodPost:
+		return s.deployFabric(fabric, s.fabricSwitches(fabric)), nil
+	case len(seg) == 2 && seg[0] == "config-deploy" && req.method == http.MethodPost:
+		return s.deployFabric(fabric, strings.Split(seg[1], ",")), nil
+	case len(seg) == 1 && seg[0] == "config-preview" && req.method == http.MethodGet:
+		return s.previewSwitches(fabric, s.fabricSwitches(fabric))
+	case len(seg) == 2 && seg[0] == "config-preview" && req.method == http.MethodGet:
+		return s.previewSwitches(fabric, strings.Split(seg[1], ","))
+	}
+	return nil, errorf(http.StatusNotFound, "No handler found for %s /%s", req.method, strings.Join(req.segments, "/"))
+}
+
+func (s *Server) listSwitches(fabric string) []interface{} {
+	serials := s.fabricSwitches(fabric)
+	res := make([]interface{}, 0, len(serials))
+	for _, serial := range serials {
+		sw := s.switches[serial].toJSON()
+		sw["ccStatus"] = s.configStatus(serial)
+		res = append(res, sw)
+	}
+	return res
+}
+
+// configStatus returns the config compliance status of a switch, which is
+// "Out-of-Sync" while configuration is pending.
+func (s *Server) configStatus(serial string) string {
+	sw := s.switches[serial]
+	pending := sw.migrating || (sw.vpcPeer != "" && !sw.vpcDeployed)
+	for _, i := range s.interfaces {
+		if !i.deployed && contains(strings.Split(i.serialNumber, "~"), serial) {
+			pending = true
+		}
+	}
+	if pending {
+		return "Out-of-Sync"
+	}
+	return "In-Sync"
+}
+
+func validCredentials(body gjson.Result) bool {
+	return body.Get("username").String() == DefaultUsername && body.Get("password").String() == DefaultPassword
+}
+
+func (s *Server) testReachability(body gjson.Result) (interface{}, *apiError) {
+	res := []interface{}{}
+	for _, ip := range strings.Split(body.Get("seedIP").String(), ",") {
+		ip = strings.TrimSpace(ip)
+		entry := map[string]interface{}{"ipaddr": ip, "reachable": false, "auth": false, "selectable": false, "known": false}
+		if sw, ok := s.spares[ip]; ok {
+			entry["reachable"] = true
+			entry["auth"] = validCredentials(body)
+			entry["selectable"] = validCredentials(body)
+			entry["sysName"] = sw.Hostname
+			entry["serialNumber"] = sw.SerialNumber
+			entry["platform"] = sw.Model
+			entry["version"] = sw.Version
+			entry["deviceIndex"] = sw.Hostname + "(" + sw.SerialNumber + ")"
+		}
+		for _, sw := range s.switches {
+			if sw.IpAddress == ip {
+				entry["reachable"] = true
+				entry["auth"] = true
+				entry["known"] = true
+				entry["sysName"] = sw.Hostname
+				entry["serialNumber"] = sw.SerialNumber
+			}
+		}
+		res = append(res, entry)
+	}
+	return res, nil
+}
+
+func (s *Server) discover(fabric string, body gjson.Result) (interface{}, *apiError) {
+	if !validCredentials(body) {
+		return nil, errorf(http.StatusBadRequest, "Authentication failed")
+	}
+	entries := body.Get("switches").Array()
+	if len(entries) == 0 {
+		return nil, errorf(http.StatusBadRequest, "Invalid payload: no switches specified")
+	}
+	for _, e := range entries {
+		ip := e.Get("ipaddr").String()
+		sw, ok := s.spares[ip]
+		if !ok {
+			return nil, errorf(http.StatusBadRequest, "Switch %s is not reachable", ip)
+		}
+		delete(s.spares, ip)
+		sw.Fabric = fabric
+		sw.migrating = true
+		sw.polls = s.DeployPolls
+		s.switches[sw.SerialNumber] = sw
+	}
+	return map[string]interface{}{"status": "Discovery has been initiated successfully"}, nil
+}
+
+func (s *Server) setRoles(req request) (interface{}, *apiError) {
+	if req.method != http.MethodPost {
+		return nil, errorf(http.StatusNotFound, "No handler found for %s /%s", req.method, strings.Join(req.segments, "/"))
+	}
+	for _, e := range req.body.Array() {
+		serial := e.Get("serialNumber").String()
+		role := e.Get("role").String()
+		sw, ok := s.switches[serial]
+		if !ok {
+			return nil, errorf(http.StatusBadRequest, "Switch with serial number %s not found", serial)
+		}
+		if !switchRoles[role] {
+			return nil, errorf(http.StatusBadRequest, "Invalid value for role: %s", role)
+		}
+		sw.Role = role
+	}
+	return []interface{}{}, nil
+}
+
+func (s *Server) removeSwitch(fabric, serial string) (interface{}, *apiError) {
+	sw, ok := s.switches[serial]
+	if !ok || sw.Fabric != fabric {
+		return nil, errorf(http.StatusBadRequest, "Switch with serial number %s not found in fabric %s", serial, fabric)
+	}
+	for _, objects := range []map[string]*topDownObject{s.vrfs, s.networks} {
+		for _, o := range objects {
+			if a, ok := o.attachments[serial]; ok && (a.attached || a.configured) {
+				return nil, errorf(http.StatusBadRequest, "Switch %s is in use by %s %s", serial, strings.TrimSuffix(o.kind.nameField, "Name"), o.name)
+			}
+		}
+	}
+	for key, i := range s.interfaces {
+		if i.serialNumber == serial {
+			delete(s.interfaces, key)
+		}
+	}
+	delete(s.switches, serial)
+	sw.Fabric = ""
+	sw.Role = "leaf"
+	sw.migrating = false
+	sw.polls = 0
+	s.spares[sw.IpAddress] = sw
+	return []interface{}{}, nil
+}
+
+// deployFabric deploys the configuration of the switches with the given
+// serial numbers, including pending interface changes, bringing discovered
+// switches out of migration mode.
+func (s *Server) deployFabric(fabric string, serials []string) map[string]interface{} {
+	for _, serial := range serials {
+		sw, ok := s.switches[serial]
+		if !ok || sw.Fabric != fabric {
+			continue
+		}
+		if sw.polls == 0 {
+			sw.migrating = false
+		}
+		sw.vpcDeployed = sw.vpcPeer != ""
+		s.deployBreakouts(serial)
+		for _, i := range s.interfaces {
+			if contains(strings.Split(i.serialNumber, "~"), serial) {
+				i.deployed = true
+			}
+		}
+	}
+	return map[string]interface{}{"status": "Configuration deployment completed"}
+}
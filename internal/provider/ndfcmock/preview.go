@@ -0,0 +1,219 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package ndfcmock
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+// The mock generates a simplified NX-OS configuration for pending changes,
+// it is only meant to be stable and recognizable in tests.
+
+// cli returns the configuration of the interface.
+func (i *iface) cli() []string {
+	lines := []string{"interface " + i.ifName}
+	add := func(format string, a ...interface{}) {
+		lines = append(lines, "  "+fmt.Sprintf(format, a...))
+	}
+	if v := i.nvPairs["DESC"]; v != "" {
+		add("description %s", v)
+	}
+	if v := i.nvPairs["ACCESS_VLAN"]; v != "" {
+		add("switchport mode access")
+		add("switchport access vlan %s", v)
+	}
+	if v := i.nvPairs["ALLOWED_VLANS"]; v != "" {
+		add("switchport mode trunk")
+		add("switchport trunk allowed vlan %s", v)
+	}
+	if v := i.nvPairs["NATIVE_VLAN"]; v != "" {
+		add("switchport trunk native vlan %s", v)
+	}
+	if v := i.nvPairs["MTU"]; v == "jumbo" {
+		add("mtu 9216")
+	}
+	for _, line := range strings.Split(i.nvPairs["CONF"], "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			add("%s", line)
+		}
+	}
+	if i.nvPairs["ADMIN_STATE"] == "false" {
+		add("shutdown")
+	} else {
+		add("no shutdown")
+	}
+	return lines
+}
+
+// objectCLI returns the configuration of an object attached to a switch, or
+// the configuration removing it if it is being detached.
+func (o *topDownObject) objectCLI(a *attachment) []string {
+	body := gjson.Parse(mustMarshal(o.body))
+	id := body.Get(o.kind.idField).Int()
+	vlan := a.vlan
+	if vlan <= 0 {
+		vlan = body.Get(o.kind.configField + "." + o.kind.vlanField).Int()
+	}
+	var lines []string
+	if o.kind == &vrfKind {
+		if !a.attached {
+			return []string{"no vrf context " + o.name, fmt.Sprintf("no vlan %d", vlan)}
+		}
+		lines = []string{
+			fmt.Sprintf("vlan %d", vlan),
+			fmt.Sprintf("  vn-segment %d", id),
+			"vrf context " + o.name,
+			fmt.Sprintf("  vni %d", id),
+			"interface nve1",
+			fmt.Sprintf("  member vni %d associate-vrf", id),
+		}
+	} else {
+		if !a.attached {
+			return []string{"interface nve1", fmt.Sprintf("  no member vni %d", id), fmt.Sprintf("no vlan %d", vlan)}
+		}
+		lines = []string{
+			fmt.Sprintf("vlan %d", vlan),
+			fmt.Sprintf("  vn-segment %d", id),
+			"interface nve1",
+			fmt.Sprintf("  member vni %d", id),
+			"    ingress-replication protocol bgp",
+		}
+		for _, port := range strings.Split(a.switchPorts, ",") {
+			if port = strings.TrimSpace(port); port != "" {
+				lines = append(lines, "interface "+port, fmt.Sprintf("  switchport trunk allowed vlan add %d", vlan))
+			}
+		}
+	}
+	for _, line := range strings.Split(a.freeformConfig, "\n") {
+		if line = strings.TrimRight(line, " \r"); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// pending reports whether the attachment has configuration that is not yet
+// deployed. The state is read without advancing a deployment.
+func (a *attachment) pending() bool {
+	switch a.state {
+	case StatePending, StateOutOfSync, StateFailed:
+		return true
+	}
+	return false
+}
+
+// pendingConfig returns the configuration that a deployment of the switch
+// would push: its vPC domain, undeployed interfaces and pending VRF and
+// network attachments.
+func (s *Server) pendingConfig(serial string) string {
+	sw := s.switches[serial]
+	var lines []string
+	if sw.vpcPeer != "" && !sw.vpcDeployed {
+		lines = append(lines, fmt.Sprintf("vpc domain %d", sw.vpcDomain), "  peer-switch", "  peer-gateway")
+	}
+	for _, i := range s.switchInterfaces(serial) {
+		if !i.deployed {
+			lines = append(lines, i.cli()...)
+		}
+	}
+	for _, k := range []*kind{&vrfKind, &networkKind} {
+		keys := []string{}
+		for key, o := range s.objects(k) {
+			if o.fabric == sw.Fabric {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			o := s.objects(k)[key]
+			if a, ok := o.attachments[serial]; ok && a.pending() {
+				lines = append(lines, o.objectCLI(a)...)
+			}
+		}
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// previewSwitches returns the pending configuration of the given switches of
+// fabric, one entry per switch.
+func (s *Server) previewSwitches(fabric string, serials []string) (interface{}, *apiError) {
+	res := []interface{}{}
+	for _, serial := range serials {
+		sw, ok := s.switches[serial]
+		if !ok || sw.Fabric != fabric {
+			return nil, errorf(http.StatusBadRequest, "Switch with serial number %s not found in fabric %s", serial, fabric)
+		}
+		config := s.pendingConfig(serial)
+		res = append(res, map[string]interface{}{
+			"switchId":      serial,
+			"hostName":      sw.Hostname,
+			"ipAddress":     sw.IpAddress,
+			"pendingConfig": config,
+			"status":        s.configStatus(serial),
+		})
+	}
+	return res, nil
+}
+
+// previewObjects returns the pending configuration of the named objects on
+// the given switches, or on all switches of the fabric if none are given.
+func (s *Server) previewObjects(k *kind, fabric string, names, serials []string) (interface{}, *apiError) {
+	if len(serials) == 0 {
+		serials = s.fabricSwitches(fabric)
+	}
+	res := []interface{}{}
+	for _, name := range names {
+		o, err := s.getObject(k, fabric, name)
+		if err != nil {
+			return nil, err
+		}
+		for _, serial := range serials {
+			sw, ok := s.switches[serial]
+			if !ok || sw.Fabric != fabric {
+				return nil, errorf(http.StatusBadRequest, "Switch with serial number %s not found in fabric %s", serial, fabric)
+			}
+			config := ""
+			if a, ok := o.attachments[serial]; ok && a.pending() {
+				config = strings.Join(o.objectCLI(a), "\n") + "\n"
+			}
+			res = append(res, map[string]interface{}{
+				k.nameField:      name,
+				"switchSerialNo": serial,
+				"configs":        config,
+			})
+		}
+	}
+	return res, nil
+}
+
+// splitQuery splits a comma separated query parameter, returning nil if it
+// is empty.
+func splitQuery(v string) []string {
+	if v == "" {
+		return nil
+	}
+	return strings.Split(v, ",")
+}
@@ -0,0 +1,248 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+// Package ndfcmock implements a stateful, in-memory stand-in for the subset of
+// the NDFC REST API used by the provider, so that acceptance tests can run
+// without a Nexus Dashboard.
+package ndfcmock
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/tidwall/gjson"
+)
+
+const (
+	BasePath = "/appcenter/cisco/ndfc/api/v1"
+
+	DefaultUsername = "admin"
+	DefaultPassword = "admin"
+	DefaultFabric   = "CML"
+
+	token = "ndfcmock-token"
+)
+
+// Switch is a switch known to the mock inventory.
+type Switch struct {
+	SerialNumber string
+	Hostname     string
+	IpAddress    string
+	Role         string
+	Model        string
+	Version      string
+	Fabric       string
+
+	// migrating is set from discovery until the fabric is deployed.
+	migrating bool
+	// polls is the number of reads the switch is reported as discovering.
+	polls int
+	// vpcPeer is the serial number of the vPC peer, if any.
+	vpcPeer         string
+	vpcDomain       int
+	virtualPeerLink bool
+	// vpcDeployed is set once the vPC configuration has been deployed.
+	vpcDeployed bool
+}
+
+// DefaultSwitches are the switches every new Server starts with. The first
+// serial number is the one used throughout the acceptance tests.
+var DefaultSwitches = []Switch{
+	{SerialNumber: "9DBYO6WQJ46", Hostname: "LEAF1", IpAddress: "10.0.0.101", Role: "leaf", Model: "N9K-C9300v", Version: "10.2(5)", Fabric: DefaultFabric},
+	{SerialNumber: "9RB5Y9BFNTU", Hostname: "LEAF2", IpAddress: "10.0.0.102", Role: "leaf", Model: "N9K-C9300v", Version: "10.2(5)", Fabric: DefaultFabric},
+	{SerialNumber: "9QBCTIN0FMY", Hostname: "SPINE1", IpAddress: "10.0.0.201", Role: "spine", Model: "N9K-C9300v", Version: "10.2(5)", Fabric: DefaultFabric},
+}
+
+// DefaultSpareSwitches are reachable switches that are not part of any
+// fabric, for discovery tests. They log in with DefaultUsername and
+// DefaultPassword.
+var DefaultSpareSwitches = []Switch{
+	{SerialNumber: "9TQYTJSZ1VJ", Hostname: "LEAF3", IpAddress: "10.0.0.103", Role: "leaf", Model: "N9K-C9300v", Version: "10.2(5)"},
+	{SerialNumber: "9PN0KPO2XKN", Hostname: "BGW1", IpAddress: "10.0.0.111", Role: "leaf", Model: "N9K-C9300v", Version: "10.2(5)"},
+}
+
+// Server is a running mock NDFC instance. All state is kept in memory and
+// lost when the server is closed.
+type Server struct {
+	*httptest.Server
+
+	// DeployPolls is the number of status reads an attachment reports
+	// "IN PROGRESS" for after a deploy, before settling to its final state.
+	DeployPolls int
+
+	mu         sync.Mutex
+	fabrics    map[string]*fabric
+	switches   map[string]*Switch
+	spares     map[string]*Switch
+	interfaces map[string]*iface
+	breakouts  map[string]*breakout
+	vrfs       map[string]*topDownObject
+	networks   map[string]*topDownObject
+}
+
+// NewServer starts a mock NDFC seeded with DefaultFabric and DefaultSwitches.
+func NewServer() *Server {
+	s := &Server{
+		DeployPolls: 1,
+		fabrics:     make(map[string]*fabric),
+		switches:    make(map[string]*Switch),
+		spares:      make(map[string]*Switch),
+		interfaces:  make(map[string]*iface),
+		breakouts:   make(map[string]*breakout),
+		vrfs:        make(map[string]*topDownObject),
+		networks:    make(map[string]*topDownObject),
+	}
+	s.fabrics[DefaultFabric] = newFabric(DefaultFabric, gjson.Parse(`{"BGP_AS":"65000"}`))
+	for i := range DefaultSwitches {
+		sw := DefaultSwitches[i]
+		s.switches[sw.SerialNumber] = &sw
+	}
+	for i := range DefaultSpareSwitches {
+		sw := DefaultSpareSwitches[i]
+		s.spares[sw.IpAddress] = &sw
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// apiError is returned by handlers to produce an NDFC style error response.
+type apiError struct {
+	status  int
+	message string
+}
+
+func (e *apiError) Error() string {
+	return e.message
+}
+
+func errorf(status int, format string, a ...interface{}) *apiError {
+	return &apiError{status: status, message: fmt.Sprintf(format, a...)}
+}
+
+// request carries the parsed parts of an incoming API call.
+type request struct {
+	method   string
+	segments []string
+	query    func(string) string
+	body     gjson.Result
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/login" && r.Method == http.MethodPost {
+		s.login(w, r)
+		return
+	}
+	if r.Header.Get("Authorization") != "Bearer "+token {
+		writeError(w, r.URL.Path, errorf(http.StatusUnauthorized, "Unauthorized"))
+		return
+	}
+	if !strings.HasPrefix(r.URL.Path, BasePath+"/") {
+		writeError(w, r.URL.Path, errorf(http.StatusNotFound, "No handler found for %s %s", r.Method, r.URL.Path))
+		return
+	}
+
+	raw, _ := io.ReadAll(r.Body)
+	req := request{
+		method:   r.Method,
+		segments: strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, BasePath), "/"), "/"),
+		query:    r.URL.Query().Get,
+		body:     gjson.ParseBytes(raw),
+	}
+
+	s.mu.Lock()
+	res, err := s.route(req)
+	s.mu.Unlock()
+
+	if err != nil {
+		log.Printf("[DEBUG] ndfcmock: %s %s: %s", r.Method, r.URL.Path, err.message)
+		writeError(w, r.URL.Path, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (s *Server) route(req request) (interface{}, *apiError) {
+	seg := req.segments
+	switch {
+	case match(seg, "lan-fabric", "rest", "control", "fabrics"):
+		return s.routeFabric(req)
+	case match(seg, "lan-fabric", "rest", "control", "switches", "roles"):
+		return s.setRoles(req)
+	case match(seg, "lan-fabric", "rest", "control", "switches", "*", "fabric-name") && req.method == http.MethodGet:
+		return s.switchFabric(seg[4])
+	case match(seg, "lan-fabric", "rest", "vpcpair"):
+		return s.routeVpcPair(req)
+	case match(seg, "lan-fabric", "rest", "interface"):
+		return s.routeInterface(req)
+	case match(seg, "configtemplate", "rest", "config", "templates"):
+		return s.routeTemplate(req)
+	case match(seg, "lan-fabric", "rest", "top-down", "v2", "fabrics", "*", "*"):
+		return s.routeTopDown(req)
+	case match(seg, "lan-fabric", "rest", "top-down", "vrfs", "deploy"):
+		return s.deploySwitches(&vrfKind, req)
+	case match(seg, "lan-fabric", "rest", "top-down", "networks", "deploy"):
+		return s.deploySwitches(&networkKind, req)
+	}
+	return nil, errorf(http.StatusNotFound, "No handler found for %s /%s", req.method, strings.Join(seg, "/"))
+}
+
+// match reports whether segments starts with pattern, where "*" matches any
+// single segment.
+func match(segments []string, pattern ...string) bool {
+	if len(segments) < len(pattern) {
+		return false
+	}
+	for i, p := range pattern {
+		if p != "*" && p != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) login(w http.ResponseWriter, r *http.Request) {
+	raw, _ := io.ReadAll(r.Body)
+	body := gjson.ParseBytes(raw)
+	if body.Get("userName").String() != DefaultUsername || body.Get("userPasswd").String() != DefaultPassword {
+		writeError(w, r.URL.Path, errorf(http.StatusUnauthorized, "Invalid username or password"))
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"token": token})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if v != nil {
+		_ = json.NewEncoder(w).Encode(v)
+	}
+}
+
+func writeError(w http.ResponseWriter, path string, err *apiError) {
+	writeJSON(w, err.status, map[string]interface{}{
+		"status":  err.status,
+		"error":   http.StatusText(err.status),
+		"message": err.message,
+		"path":    path,
+	})
+}
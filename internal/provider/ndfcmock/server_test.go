@@ -0,0 +1,448 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package ndfcmock
+
+import (
+	"testing"
+
+	"github.com/netascode/go-nd"
+)
+
+func newTestClient(t *testing.T) (*Server, *nd.Client) {
+	t.Helper()
+	server := NewServer()
+	t.Cleanup(server.Close)
+	client, err := nd.NewClient(server.URL, BasePath, DefaultUsername, DefaultPassword, "", true, nd.MaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return server, &client
+}
+
+func TestInterfaceLifecycle(t *testing.T) {
+	_, client := newTestClient(t)
+	path := "/lan-fabric/rest/interface"
+
+	body := `{"policy":"int_loopback","interfaces":[{"serialNumber":"9DBYO6WQJ46","ifName":"loopback123","nvPairs":{"INTF_NAME":"loopback123","DESC":"test"}}]}`
+	if _, err := client.Post(path, body); err != nil {
+		t.Fatalf("create interface: %v", err)
+	}
+	if _, err := client.Post(path, body); err == nil {
+		t.Fatal("expected error when creating an existing interface")
+	}
+
+	res, err := client.Get(path + "?serialNumber=9DBYO6WQJ46&ifName=loopback123")
+	if err != nil {
+		t.Fatalf("get interface: %v", err)
+	}
+	if got := res.Get("0.interfaces.0.nvPairs.DESC").String(); got != "test" {
+		t.Errorf("DESC = %q, want %q", got, "test")
+	}
+	if got := res.Get("0.interfaces.0.complianceStatus").String(); got != "Pending" {
+		t.Errorf("complianceStatus = %q, want %q", got, "Pending")
+	}
+
+	if _, err := client.Post(path+"/deploy", `[{"serialNumber":"9DBYO6WQJ46","ifName":"loopback123"}]`); err != nil {
+		t.Fatalf("deploy interface: %v", err)
+	}
+	res, _ = client.Get(path + "?serialNumber=9DBYO6WQJ46&ifName=loopback123")
+	if got := res.Get("0.interfaces.0.complianceStatus").String(); got != "In-Sync" {
+		t.Errorf("complianceStatus = %q, want %q", got, "In-Sync")
+	}
+
+	if _, err := client.Delete(path, `[{"serialNumber":"9DBYO6WQJ46","ifName":"loopback123"}]`); err != nil {
+		t.Fatalf("delete interface: %v", err)
+	}
+	if _, err := client.Get(path + "?serialNumber=9DBYO6WQJ46&ifName=loopback123"); err == nil {
+		t.Fatal("expected error reading a deleted interface")
+	}
+}
+
+func TestVrfAttachAndDeploy(t *testing.T) {
+	server, client := newTestClient(t)
+	server.DeployPolls = 2
+	path := "/lan-fabric/rest/top-down/v2/fabrics/CML/vrfs/"
+
+	res, err := client.Post(path, `{"fabric":"CML","vrfName":"VRF1","vrfTemplateConfig":{"vrfDescription":"x"}}`)
+	if err != nil {
+		t.Fatalf("create vrf: %v", err)
+	}
+	if got := res.Get("vrfId").Int(); got != 50000 {
+		t.Errorf("vrfId = %d, want 50000", got)
+	}
+
+	attach := `[{"vrfName":"VRF1","lanAttachList":[{"fabric":"CML","vrfName":"VRF1","serialNumber":"9DBYO6WQJ46","vlan":-1,"deployment":true}]}]`
+	res, err = client.Post(path+"attachments", attach)
+	if err != nil {
+		t.Fatalf("attach vrf: %v", err)
+	}
+	if got := res.Get(`VRF1-\[9DBYO6WQJ46/LEAF1\]`).String(); got != "SUCCESS" {
+		t.Errorf("attach response = %s", res.Raw)
+	}
+
+	state := func() string {
+		res, err := client.Get(path + "attachments?vrf-names=VRF1")
+		if err != nil {
+			t.Fatalf("get attachments: %v", err)
+		}
+		return res.Get(`0.lanAttachList.#(switchSerialNo="9DBYO6WQJ46").lanAttachState`).String()
+	}
+	if got := state(); got != StatePending {
+		t.Fatalf("state = %q, want %q", got, StatePending)
+	}
+
+	if _, err := client.Post("/lan-fabric/rest/top-down/vrfs/deploy", `{"9DBYO6WQJ46":"VRF1"}`); err != nil {
+		t.Fatalf("deploy vrf: %v", err)
+	}
+	for i := 0; i < server.DeployPolls; i++ {
+		if got := state(); got != StateInProgress {
+			t.Fatalf("poll %d: state = %q, want %q", i, got, StateInProgress)
+		}
+	}
+	if got := state(); got != StateDeployed {
+		t.Fatalf("state = %q, want %q", got, StateDeployed)
+	}
+	if !server.SetAttachmentState("vrfs", "CML", "VRF1", "9DBYO6WQJ46", StateOutOfSync) {
+		t.Fatal("set attachment state: not attached")
+	}
+	if got := state(); got != StateOutOfSync {
+		t.Fatalf("state = %q, want %q", got, StateOutOfSync)
+	}
+	if server.SetAttachmentState("vrfs", "CML", "VRF1", "9RB5Y9BFNTU", StateOutOfSync) {
+		t.Error("set attachment state of a detached switch succeeded")
+	}
+
+	if _, err := client.Delete(path+"VRF1", ""); err == nil {
+		t.Fatal("expected error deleting an attached vrf")
+	}
+
+	detach := `[{"vrfName":"VRF1","lanAttachList":[{"fabric":"CML","vrfName":"VRF1","serialNumber":"9DBYO6WQJ46","deployment":false}]}]`
+	if _, err := client.Post(path+"attachments", detach); err != nil {
+		t.Fatalf("detach vrf: %v", err)
+	}
+	if _, err := client.Post("/lan-fabric/rest/top-down/vrfs/deploy", `{"9DBYO6WQJ46":"VRF1"}`); err != nil {
+		t.Fatalf("deploy vrf: %v", err)
+	}
+	for i := 0; i < server.DeployPolls; i++ {
+		state()
+	}
+	if got := state(); got != StateNA {
+		t.Fatalf("state = %q, want %q", got, StateNA)
+	}
+	if _, err := client.Delete(path+"VRF1", ""); err != nil {
+		t.Fatalf("delete vrf: %v", err)
+	}
+}
+
+func TestNetworkStatus(t *testing.T) {
+	_, client := newTestClient(t)
+	vrfs := "/lan-fabric/rest/top-down/v2/fabrics/CML/vrfs/"
+	networks := "/lan-fabric/rest/top-down/v2/fabrics/CML/networks/"
+
+	if _, err := client.Post(networks, `{"fabric":"CML","networkName":"NET1","vrf":"VRF1"}`); err == nil {
+		t.Fatal("expected error creating a network in a missing vrf")
+	}
+	if _, err := client.Post(vrfs, `{"fabric":"CML","vrfName":"VRF1"}`); err != nil {
+		t.Fatalf("create vrf: %v", err)
+	}
+	if _, err := client.Post(networks, `{"fabric":"CML","networkName":"NET1","vrf":"VRF1"}`); err != nil {
+		t.Fatalf("create network: %v", err)
+	}
+	attach := `[{"networkName":"NET1","lanAttachList":[{"fabric":"CML","networkName":"NET1","serialNumber":"9RB5Y9BFNTU","switchPorts":"Ethernet1/10","vlan":-1,"deployment":true}]}]`
+	if _, err := client.Post(networks+"attachments", attach); err != nil {
+		t.Fatalf("attach network: %v", err)
+	}
+	if _, err := client.Post(networks+"deployments", `{"networkNames":"NET1"}`); err != nil {
+		t.Fatalf("deploy network: %v", err)
+	}
+	status := func() string {
+		res, err := client.Get(networks)
+		if err != nil {
+			t.Fatalf("get networks: %v", err)
+		}
+		return res.Get(`#(networkName="NET1").networkStatus`).String()
+	}
+	if got := status(); got != StateInProgress {
+		t.Fatalf("status = %q, want %q", got, StateInProgress)
+	}
+	if got := status(); got != StateDeployed {
+		t.Fatalf("status = %q, want %q", got, StateDeployed)
+	}
+	res, _ := client.Get(networks + "attachments?network-names=NET1")
+	if got := res.Get(`0.lanAttachList.#(switchSerialNo="9RB5Y9BFNTU").portNames`).String(); got != "Ethernet1/10" {
+		t.Errorf("portNames = %q, want %q", got, "Ethernet1/10")
+	}
+}
+
+func TestFabricLifecycle(t *testing.T) {
+	_, client := newTestClient(t)
+	path := "/lan-fabric/rest/control/fabrics/"
+
+	if _, err := client.Post(path+"FABRIC1/Easy_Fabric", `{"FABRIC_NAME":"FABRIC1"}`); err == nil {
+		t.Fatal("expected error creating a fabric without BGP_AS")
+	}
+	if _, err := client.Post(path+"FABRIC1/Easy_Fabric", `{"FABRIC_NAME":"FABRIC1","BGP_AS":"65001","REPLICATION_MODE":"Ingress"}`); err != nil {
+		t.Fatalf("create fabric: %v", err)
+	}
+	res, err := client.Get(path + "FABRIC1")
+	if err != nil {
+		t.Fatalf("get fabric: %v", err)
+	}
+	if got := res.Get("nvPairs.REPLICATION_MODE").String(); got != "Ingress" {
+		t.Errorf("REPLICATION_MODE = %q, want %q", got, "Ingress")
+	}
+	if got := res.Get("nvPairs.LINK_STATE_ROUTING").String(); got != "ospf" {
+		t.Errorf("LINK_STATE_ROUTING = %q, want %q", got, "ospf")
+	}
+
+	if _, err := client.Post("/lan-fabric/rest/top-down/v2/fabrics/FABRIC1/vrfs/", `{"fabric":"FABRIC1","vrfName":"VRF1"}`); err != nil {
+		t.Fatalf("create vrf: %v", err)
+	}
+	if _, err := client.Delete(path+"FABRIC1", ""); err == nil {
+		t.Fatal("expected error deleting a fabric in use")
+	}
+	if _, err := client.Delete("/lan-fabric/rest/top-down/v2/fabrics/FABRIC1/vrfs/VRF1", ""); err != nil {
+		t.Fatalf("delete vrf: %v", err)
+	}
+	if _, err := client.Delete(path+"FABRIC1", ""); err != nil {
+		t.Fatalf("delete fabric: %v", err)
+	}
+	if _, err := client.Get(path + "FABRIC1"); err == nil {
+		t.Fatal("expected error reading a deleted fabric")
+	}
+}
+
+func TestSwitchRemoval(t *testing.T) {
+	server, client := newTestClient(t)
+	server.DeployPolls = 0
+	vrfs := "/lan-fabric/rest/top-down/v2/fabrics/CML/vrfs/"
+
+	if _, err := client.Post(vrfs, `{"fabric":"CML","vrfName":"VRF1"}`); err != nil {
+		t.Fatalf("create vrf: %v", err)
+	}
+	attach := `[{"vrfName":"VRF1","lanAttachList":[{"fabric":"CML","vrfName":"VRF1","serialNumber":"9DBYO6WQJ46","vlan":-1,"deployment":true}]}]`
+	if _, err := client.Post(vrfs+"attachments", attach); err != nil {
+		t.Fatalf("attach vrf: %v", err)
+	}
+	if _, err := client.Delete("/lan-fabric/rest/control/fabrics/CML/switches/9DBYO6WQJ46", ""); err == nil {
+		t.Fatal("expected error removing a switch with attachments")
+	}
+	if _, err := client.Delete("/lan-fabric/rest/control/fabrics/CML/switches/9RB5Y9BFNTU", ""); err != nil {
+		t.Fatalf("remove switch: %v", err)
+	}
+	res, err := client.Get("/lan-fabric/rest/control/fabrics/CML/inventory/switchesByFabric")
+	if err != nil {
+		t.Fatalf("get switches: %v", err)
+	}
+	if res.Get(`#(serialNumber="9RB5Y9BFNTU")`).Exists() {
+		t.Error("removed switch still in inventory")
+	}
+	res, err = client.Post("/lan-fabric/rest/control/fabrics/CML/inventory/test-reachability", `{"seedIP":"10.0.0.102","username":"admin","password":"admin"}`)
+	if err != nil {
+		t.Fatalf("test reachability: %v", err)
+	}
+	if !res.Get("0.selectable").Bool() {
+		t.Errorf("removed switch is not selectable for discovery: %s", res.Raw)
+	}
+}
+
+func TestVpcPairLifecycle(t *testing.T) {
+	_, client := newTestClient(t)
+	path := "/lan-fabric/rest/vpcpair"
+
+	if _, err := client.Post(path, `{"peerOneId":"9DBYO6WQJ46","peerTwoId":"9DBYO6WQJ46"}`); err == nil {
+		t.Fatal("expected error pairing a switch with itself")
+	}
+	if _, err := client.Post(path, `{"peerOneId":"9DBYO6WQJ46","peerTwoId":"9RB5Y9BFNTU","useVirtualPeerlink":true}`); err != nil {
+		t.Fatalf("create vpc pair: %v", err)
+	}
+	if _, err := client.Post(path, `{"peerOneId":"9DBYO6WQJ46","peerTwoId":"9QBCTIN0FMY"}`); err == nil {
+		t.Fatal("expected error pairing an already paired switch")
+	}
+	res, err := client.Get(path + "?serialNumber=9RB5Y9BFNTU")
+	if err != nil {
+		t.Fatalf("get vpc pair: %v", err)
+	}
+	if got := res.Get("peerOneId").String(); got != "9RB5Y9BFNTU" {
+		t.Errorf("peerOneId = %q, want %q", got, "9RB5Y9BFNTU")
+	}
+	if !res.Get("useVirtualPeerlink").Bool() {
+		t.Error("useVirtualPeerlink = false, want true")
+	}
+
+	overview := func() string {
+		res, err := client.Get(path + "/overview?serialNumber=9DBYO6WQJ46")
+		if err != nil {
+			t.Fatalf("get vpc pair overview: %v", err)
+		}
+		return res.Get("overview.consistencyStatus").String()
+	}
+	if got := overview(); got != "NA" {
+		t.Errorf("consistencyStatus = %q, want %q", got, "NA")
+	}
+	if _, err := client.Post("/lan-fabric/rest/control/fabrics/CML/config-deploy/9DBYO6WQJ46,9RB5Y9BFNTU", ""); err != nil {
+		t.Fatalf("deploy switches: %v", err)
+	}
+	if got := overview(); got != "consistent" {
+		t.Errorf("consistencyStatus = %q, want %q", got, "consistent")
+	}
+
+	if _, err := client.Delete(path+"?serialNumber=9DBYO6WQJ46", ""); err != nil {
+		t.Fatalf("delete vpc pair: %v", err)
+	}
+	if _, err := client.Get(path + "?serialNumber=9RB5Y9BFNTU"); err == nil {
+		t.Fatal("expected error reading a deleted vpc pair")
+	}
+}
+
+func TestPortChannelMembers(t *testing.T) {
+	_, client := newTestClient(t)
+	path := "/lan-fabric/rest/interface"
+
+	body := `{"policy":"int_port_channel_trunk_host","interfaceType":"INTERFACE_PORT_CHANNEL","interfaces":[{"serialNumber":"9DBYO6WQJ46","ifName":"Port-channel10","nvPairs":{"PO_ID":"Port-channel10","MEMBER_INTERFACES":"Ethernet1/10,Ethernet1/11"}}]}`
+	if _, err := client.Post(path, body); err != nil {
+		t.Fatalf("create port-channel: %v", err)
+	}
+	body = `{"policy":"int_port_channel_trunk_host","interfaceType":"INTERFACE_PORT_CHANNEL","interfaces":[{"serialNumber":"9DBYO6WQJ46","ifName":"Port-channel11","nvPairs":{"PO_ID":"Port-channel11","MEMBER_INTERFACES":"ethernet1/11"}}]}`
+	if _, err := client.Post(path, body); err == nil {
+		t.Fatal("expected error reusing a member interface")
+	}
+	body = `{"policy":"int_port_channel_trunk_host","interfaceType":"INTERFACE_PORT_CHANNEL","interfaces":[{"serialNumber":"9DBYO6WQJ46","ifName":"Port-channel10","nvPairs":{"PO_ID":"Port-channel10","MEMBER_INTERFACES":"Ethernet1/10"}}]}`
+	if _, err := client.Put(path, body); err != nil {
+		t.Fatalf("update port-channel: %v", err)
+	}
+	res, err := client.Get(path + "?serialNumber=9DBYO6WQJ46&ifName=Port-channel10")
+	if err != nil {
+		t.Fatalf("get port-channel: %v", err)
+	}
+	if got := res.Get("0.interfaces.0.nvPairs.MEMBER_INTERFACES").String(); got != "Ethernet1/10" {
+		t.Errorf("MEMBER_INTERFACES = %q, want %q", got, "Ethernet1/10")
+	}
+}
+
+func TestVpcInterface(t *testing.T) {
+	_, client := newTestClient(t)
+	path := "/lan-fabric/rest/interface"
+
+	body := `{"policy":"int_vpc_trunk_host","interfaceType":"INTERFACE_VPC","interfaces":[{"serialNumber":"9DBYO6WQJ46~9RB5Y9BFNTU","ifName":"vPC10","nvPairs":{"PEER1_MEMBER_INTERFACES":"Ethernet1/10","PEER2_MEMBER_INTERFACES":"Ethernet1/10"}}]}`
+	if _, err := client.Post(path, body); err == nil {
+		t.Fatal("expected error creating a vPC on unpaired switches")
+	}
+	if _, err := client.Post("/lan-fabric/rest/vpcpair", `{"peerOneId":"9DBYO6WQJ46","peerTwoId":"9RB5Y9BFNTU"}`); err != nil {
+		t.Fatalf("create vpc pair: %v", err)
+	}
+	if _, err := client.Post(path, body); err != nil {
+		t.Fatalf("create vpc: %v", err)
+	}
+	pc := `{"policy":"int_port_channel_trunk_host","interfaceType":"INTERFACE_PORT_CHANNEL","interfaces":[{"serialNumber":"9RB5Y9BFNTU","ifName":"Port-channel20","nvPairs":{"MEMBER_INTERFACES":"Ethernet1/10"}}]}`
+	if _, err := client.Post(path, pc); err == nil {
+		t.Fatal("expected error reusing a vPC member interface")
+	}
+	if _, err := client.Post(path+"/deploy", `[{"serialNumber":"9DBYO6WQJ46~9RB5Y9BFNTU","ifName":"vPC10"}]`); err != nil {
+		t.Fatalf("deploy vpc: %v", err)
+	}
+	res, err := client.Get(path + "?serialNumber=9RB5Y9BFNTU")
+	if err != nil {
+		t.Fatalf("get interfaces: %v", err)
+	}
+	if got := res.Get("0.interfaces.0.complianceStatus").String(); got != "In-Sync" {
+		t.Errorf("complianceStatus = %q, want %q", got, "In-Sync")
+	}
+	if _, err := client.Delete("/lan-fabric/rest/vpcpair?serialNumber=9DBYO6WQJ46", ""); err == nil {
+		t.Fatal("expected error deleting a vPC pair with vPC interfaces")
+	}
+	if _, err := client.Delete(path, `[{"serialNumber":"9DBYO6WQJ46~9RB5Y9BFNTU","ifName":"vPC10"}]`); err != nil {
+		t.Fatalf("delete vpc: %v", err)
+	}
+}
+
+func TestSubinterface(t *testing.T) {
+	_, client := newTestClient(t)
+	path := "/lan-fabric/rest/interface"
+
+	if _, err := client.Get(path + "?serialNumber=9DBYO6WQJ46&ifName=Ethernet1/10.100"); err == nil {
+		t.Fatal("expected error reading a subinterface that was not created")
+	}
+	body := `{"policy":"int_subif","interfaceType":"SUBINTERFACE","interfaces":[{"serialNumber":"9DBYO6WQJ46","ifName":"Ethernet1/10.100","nvPairs":{"INTF_NAME":"Ethernet1/10.100","VLAN":"100"}}]}`
+	if _, err := client.Put(path, body); err == nil {
+		t.Fatal("expected error updating a subinterface that was not created")
+	}
+	if _, err := client.Post(path, body); err != nil {
+		t.Fatalf("create subinterface: %v", err)
+	}
+	if _, err := client.Delete(path, `[{"serialNumber":"9DBYO6WQJ46","ifName":"Ethernet1/10.100"}]`); err != nil {
+		t.Fatalf("delete subinterface: %v", err)
+	}
+	if _, err := client.Get(path + "?serialNumber=9DBYO6WQJ46&ifName=Ethernet1/10.100"); err == nil {
+		t.Fatal("expected error reading a deleted subinterface")
+	}
+}
+
+func TestInterfaceReset(t *testing.T) {
+	_, client := newTestClient(t)
+	path := "/lan-fabric/rest/interface"
+
+	body := `{"policy":"int_access_host","interfaces":[{"serialNumber":"9DBYO6WQJ46","ifName":"Ethernet1/13","nvPairs":{"INTF_NAME":"Ethernet1/13","ACCESS_VLAN":"500","DESC":"server"}}]}`
+	if _, err := client.Put(path, body); err != nil {
+		t.Fatalf("configure interface: %v", err)
+	}
+	body = `{"policy":"int_trunk_host","interfaces":[{"serialNumber":"9DBYO6WQJ46","ifName":"Ethernet1/13","nvPairs":{"INTF_NAME":"Ethernet1/13"}}]}`
+	if _, err := client.Put(path, body); err != nil {
+		t.Fatalf("reset interface: %v", err)
+	}
+	res, err := client.Get(path + "?serialNumber=9DBYO6WQJ46&ifName=Ethernet1/13")
+	if err != nil {
+		t.Fatalf("get interface: %v", err)
+	}
+	if got := res.Get("0.policy").String(); got != "int_trunk_host" {
+		t.Errorf("policy = %q, want %q", got, "int_trunk_host")
+	}
+	if res.Get("0.interfaces.0.nvPairs.ACCESS_VLAN").Exists() || res.Get("0.interfaces.0.nvPairs.DESC").Exists() {
+		t.Errorf("nvPairs not reset: %s", res.Get("0.interfaces.0.nvPairs").Raw)
+	}
+}
+
+func TestBulkInterfaces(t *testing.T) {
+	_, client := newTestClient(t)
+	path := "/lan-fabric/rest/interface"
+
+	body := `{"policy":"int_access_host","interfaces":[` +
+		`{"serialNumber":"9DBYO6WQJ46","ifName":"Ethernet1/20","nvPairs":{"INTF_NAME":"Ethernet1/20","ACCESS_VLAN":"500"}},` +
+		`{"serialNumber":"9RB5Y9BFNTU","ifName":"Ethernet1/20","nvPairs":{"INTF_NAME":"Ethernet1/20","ACCESS_VLAN":"501"}}]}`
+	if _, err := client.Put(path, body); err != nil {
+		t.Fatalf("configure interfaces: %v", err)
+	}
+	if _, err := client.Post(path+"/deploy", `[{"serialNumber":"9DBYO6WQJ46","ifName":"Ethernet1/20"},{"serialNumber":"9RB5Y9BFNTU","ifName":"Ethernet1/20"}]`); err != nil {
+		t.Fatalf("deploy interfaces: %v", err)
+	}
+	for serial, vlan := range map[string]string{"9DBYO6WQJ46": "500", "9RB5Y9BFNTU": "501"} {
+		res, err := client.Get(path + "?serialNumber=" + serial)
+		if err != nil {
+			t.Fatalf("get interfaces: %v", err)
+		}
+		if got := res.Get("#").Int(); got != 1 {
+			t.Fatalf("%s: got %d interfaces, want 1", serial, got)
+		}
+		if got := res.Get("0.interfaces.0.nvPairs.ACCESS_VLAN").String(); got != vlan {
+			t.Errorf("%s: ACCESS_VLAN = %q, want %q", serial, got, vlan)
+		}
+		if got := res.Get("0.interfaces.0.complianceStatus").String(); got != "In-Sync" {
+			t.Errorf("%s: complianceStatus = %q, want %q", serial, got, "In-Sync")
+		}
+	}
+}
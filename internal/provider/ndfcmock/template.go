@@ -0,0 +1,97 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package ndfcmock
+
+import (
+	"net/http"
+	"strings"
+)
+
+// templateParameter is a parameter of a configuration template.
+type templateParameter struct {
+	name         string
+	defaultValue string
+	// noDefault is set for parameters without a default value.
+	noDefault bool
+	internal  bool
+}
+
+// hostInterfaceTemplate returns the parameters of a host interface policy,
+// the parameters shared by all of them followed by params.
+func hostInterfaceTemplate(params ...templateParameter) []templateParameter {
+	return append([]templateParameter{
+		{name: "POLICY_ID", defaultValue: "POLICY-0", internal: true},
+		{name: "INTF_NAME", noDefault: true},
+		{name: "BPDUGUARD_ENABLED", defaultValue: "true"},
+		{name: "PORTTYPE_FAST_ENABLED", defaultValue: "true"},
+		{name: "MTU", defaultValue: "jumbo"},
+		{name: "SPEED", defaultValue: "Auto"},
+		{name: "DESC", defaultValue: ""},
+		{name: "CONF", defaultValue: ""},
+		{name: "ADMIN_STATE", defaultValue: "true"},
+		{name: "ENABLE_ORPHAN_PORT", defaultValue: "false"},
+		{name: "PTP", defaultValue: "false"},
+		{name: "ENABLE_NETFLOW", defaultValue: "false"},
+		{name: "NETFLOW_MONITOR", defaultValue: ""},
+		{name: "NETFLOW_SAMPLER", defaultValue: ""},
+	}, params...)
+}
+
+// templates are the configuration templates known to the mock.
+var templates = map[string][]templateParameter{
+	"int_trunk_host": hostInterfaceTemplate(
+		templateParameter{name: "ALLOWED_VLANS", defaultValue: "none"},
+		templateParameter{name: "NATIVE_VLAN", noDefault: true},
+	),
+	"int_access_host": hostInterfaceTemplate(
+		templateParameter{name: "ACCESS_VLAN", noDefault: true},
+	),
+}
+
+func (s *Server) routeTemplate(req request) (interface{}, *apiError) {
+	seg := req.segments[4:]
+	if len(seg) == 1 && req.method == http.MethodGet {
+		return getTemplate(seg[0])
+	}
+	return nil, errorf(http.StatusNotFound, "No handler found for %s /%s", req.method, strings.Join(req.segments, "/"))
+}
+
+func getTemplate(name string) (interface{}, *apiError) {
+	params, ok := templates[name]
+	if !ok {
+		return nil, errorf(http.StatusNotFound, "Template %s not found", name)
+	}
+	res := []map[string]interface{}{}
+	for _, p := range params {
+		meta := map[string]interface{}{}
+		if !p.noDefault {
+			meta["defaultValue"] = p.defaultValue
+		}
+		annotations := map[string]interface{}{}
+		if p.internal {
+			annotations["IsInternal"] = "true"
+		}
+		res = append(res, map[string]interface{}{
+			"name":           p.name,
+			"parameterType":  "string",
+			"metaProperties": meta,
+			"annotations":    annotations,
+		})
+	}
+	return map[string]interface{}{"name": name, "parameters": res}, nil
+}
@@ -0,0 +1,548 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package ndfcmock
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+// Attachment states as reported in lanAttachState.
+const (
+	StateNA         = "NA"
+	StatePending    = "PENDING"
+	StateInProgress = "IN PROGRESS"
+	StateDeployed   = "DEPLOYED"
+	StateOutOfSync  = "OUT-OF-SYNC"
+	StateFailed     = "FAILED"
+)
+
+// kind describes the differences between the VRF and network top-down APIs.
+type kind struct {
+	collection  string
+	nameField   string
+	idField     string
+	statusField string
+	namesQuery  string
+	configField string
+	vlanField   string
+	firstId     int64
+	firstVlan   int64
+}
+
+var (
+	vrfKind = kind{
+		collection:  "vrfs",
+		nameField:   "vrfName",
+		idField:     "vrfId",
+		statusField: "vrfStatus",
+		namesQuery:  "vrf-names",
+		configField: "vrfTemplateConfig",
+		vlanField:   "vrfVlanId",
+		firstId:     50000,
+		firstVlan:   2000,
+	}
+	networkKind = kind{
+		collection:  "networks",
+		nameField:   "networkName",
+		idField:     "networkId",
+		statusField: "networkStatus",
+		namesQuery:  "network-names",
+		configField: "networkTemplateConfig",
+		vlanField:   "vlanId",
+		firstId:     30000,
+		firstVlan:   2300,
+	}
+)
+
+type topDownObject struct {
+	kind        *kind
+	fabric      string
+	name        string
+	body        map[string]interface{}
+	attachments map[string]*attachment
+}
+
+type attachment struct {
+	// attached is the intent as last posted to the attachments API.
+	attached bool
+	// configured is whether the switch currently carries the deployed config.
+	configured        bool
+	state             string
+	polls             int
+	vlan              int64
+	switchPorts       string
+	detachSwitchPorts string
+	freeformConfig    string
+	instanceValues    string
+	extensionValues   string
+}
+
+// observe returns the current state of the attachment, advancing an ongoing
+// deployment by one step.
+func (a *attachment) observe() string {
+	if a.state != StateInProgress {
+		return a.state
+	}
+	if a.polls > 0 {
+		a.polls--
+		return a.state
+	}
+	a.configured = a.attached
+	if a.attached {
+		a.state = StateDeployed
+	} else {
+		a.state = StateNA
+	}
+	return a.state
+}
+
+func (s *Server) objects(k *kind) map[string]*topDownObject {
+	if k == &vrfKind {
+		return s.vrfs
+	}
+	return s.networks
+}
+
+func objectKey(fabric, name string) string {
+	return fabric + "/" + name
+}
+
+func (s *Server) routeTopDown(req request) (interface{}, *apiError) {
+	fabric := req.segments[5]
+	var k *kind
+	switch req.segments[6] {
+	case vrfKind.collection:
+		k = &vrfKind
+	case networkKind.collection:
+		k = &networkKind
+	default:
+		return nil, errorf(http.StatusNotFound, "No handler found for %s /%s", req.method, strings.Join(req.segments, "/"))
+	}
+	if !s.fabricExists(fabric) {
+		return nil, errorf(http.StatusBadRequest, "Fabric %s not found", fabric)
+	}
+	seg := req.segments[7:]
+	switch {
+	case len(seg) == 0 && req.method == http.MethodGet:
+		return s.listObjects(k, fabric), nil
+	case len(seg) == 0 && req.method == http.MethodPost:
+		return s.createObject(k, fabric, req.body)
+	case len(seg) == 1 && seg[0] == "attachments" && req.method == http.MethodGet:
+		return s.getAttachments(k, fabric, strings.Split(req.query(k.namesQuery), ","))
+	case len(seg) == 1 && seg[0] == "attachments" && req.method == http.MethodPost:
+		return s.postAttachments(k, fabric, req.body)
+	case len(seg) == 1 && seg[0] == "previews" && req.method == http.MethodGet:
+		return s.previewObjects(k, fabric, strings.Split(req.query(k.namesQuery), ","), splitQuery(req.query("serial-numbers")))
+	case len(seg) == 1 && seg[0] == "deployments" && req.method == http.MethodPost:
+		names := req.body.Get(strings.TrimSuffix(k.nameField, "Name") + "Names").String()
+		return s.deployObjects(k, fabric, strings.Split(names, ","), nil)
+	case len(seg) == 1 && req.method == http.MethodGet:
+		o, err := s.getObject(k, fabric, seg[0])
+		if err != nil {
+			return nil, err
+		}
+		return s.objectJSON(o), nil
+	case len(seg) == 1 && req.method == http.MethodPut:
+		return s.updateObject(k, fabric, seg[0], req.body)
+	case len(seg) == 1 && req.method == http.MethodDelete:
+		return s.deleteObject(k, fabric, seg[0])
+	}
+	return nil, errorf(http.StatusNotFound, "No handler found for %s /%s", req.method, strings.Join(req.segments, "/"))
+}
+
+// SetAttachmentState sets the state of the attachment of a VRF or network to
+// a switch, e.g. to OUT-OF-SYNC as if the switch was changed outside of NDFC.
+// collection is "vrfs" or "networks". It returns false if the object is not
+// attached to the switch.
+func (s *Server) SetAttachmentState(collection, fabric, name, serial, state string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	k := &vrfKind
+	if collection == networkKind.collection {
+		k = &networkKind
+	}
+	o, ok := s.objects(k)[objectKey(fabric, name)]
+	if !ok {
+		return false
+	}
+	a, ok := o.attachments[serial]
+	if !ok || !a.attached {
+		return false
+	}
+	a.state = state
+	return true
+}
+
+func (s *Server) fabricExists(fabric string) bool {
+	_, ok := s.fabrics[fabric]
+	return ok
+}
+
+// fabricSwitches returns the serial numbers of all switches in a fabric.
+func (s *Server) fabricSwitches(fabric string) []string {
+	serials := []string{}
+	for serial, sw := range s.switches {
+		if sw.Fabric == fabric {
+			serials = append(serials, serial)
+		}
+	}
+	sort.Strings(serials)
+	return serials
+}
+
+func (s *Server) getObject(k *kind, fabric, name string) (*topDownObject, *apiError) {
+	o, ok := s.objects(k)[objectKey(fabric, name)]
+	if !ok {
+		return nil, errorf(http.StatusBadRequest, "%s %s does not exist in fabric %s", strings.TrimSuffix(k.nameField, "Name"), name, fabric)
+	}
+	return o, nil
+}
+
+// status aggregates the attachment states into the object status.
+func (o *topDownObject) status() string {
+	states := map[string]bool{}
+	for _, a := range o.attachments {
+		states[a.observe()] = true
+	}
+	for _, st := range []string{StateInProgress, StateFailed, StateOutOfSync, StatePending, StateDeployed} {
+		if states[st] {
+			return st
+		}
+	}
+	return StateNA
+}
+
+func (s *Server) objectJSON(o *topDownObject) map[string]interface{} {
+	res := make(map[string]interface{}, len(o.body)+1)
+	for k, v := range o.body {
+		res[k] = v
+	}
+	res[o.kind.statusField] = o.status()
+	return res
+}
+
+func (s *Server) listObjects(k *kind, fabric string) []interface{} {
+	keys := []string{}
+	for key, o := range s.objects(k) {
+		if o.fabric == fabric {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	res := []interface{}{}
+	for _, key := range keys {
+		res = append(res, s.objectJSON(s.objects(k)[key]))
+	}
+	return res
+}
+
+// nextValue returns the lowest value >= first not yet used for path in any
+// object of the kind.
+func (s *Server) nextValue(k *kind, path string, first int64) int64 {
+	used := map[int64]bool{}
+	for _, o := range s.objects(k) {
+		used[gjson.Get(mustMarshal(o.body), path).Int()] = true
+	}
+	for v := first; ; v++ {
+		if !used[v] {
+			return v
+		}
+	}
+}
+
+func (s *Server) decodeObject(k *kind, fabric string, body gjson.Result) (map[string]interface{}, *apiError) {
+	obj, ok := body.Value().(map[string]interface{})
+	if !ok {
+		return nil, errorf(http.StatusBadRequest, "Invalid payload")
+	}
+	obj["fabric"] = fabric
+	config, _ := obj[k.configField].(map[string]interface{})
+	if config == nil {
+		// NDFC sends the template config as an embedded JSON string.
+		config = map[string]interface{}{}
+		if str, ok := obj[k.configField].(string); ok && str != "" {
+			_ = json.Unmarshal([]byte(str), &config)
+		}
+	}
+	obj[k.configField] = config
+	return obj, nil
+}
+
+func (s *Server) createObject(k *kind, fabric string, body gjson.Result) (interface{}, *apiError) {
+	name := body.Get(k.nameField).String()
+	if name == "" {
+		return nil, errorf(http.StatusBadRequest, "%s is mandatory", k.nameField)
+	}
+	if _, ok := s.objects(k)[objectKey(fabric, name)]; ok {
+		return nil, errorf(http.StatusBadRequest, "%s %s already exists in fabric %s", strings.TrimSuffix(k.nameField, "Name"), name, fabric)
+	}
+	obj, err := s.decodeObject(k, fabric, body)
+	if err != nil {
+		return nil, err
+	}
+	if k == &networkKind {
+		vrf := body.Get("vrf").String()
+		if _, ok := s.vrfs[objectKey(fabric, vrf)]; !ok && vrf != "NA" {
+			return nil, errorf(http.StatusBadRequest, "VRF %s does not exist in fabric %s", vrf, fabric)
+		}
+		if _, ok := obj["displayName"]; !ok {
+			obj["displayName"] = name
+		}
+	}
+	if _, ok := obj[k.idField]; !ok {
+		obj[k.idField] = s.nextValue(k, k.idField, k.firstId)
+	}
+	config := obj[k.configField].(map[string]interface{})
+	if _, ok := config[k.vlanField]; !ok {
+		config[k.vlanField] = s.nextValue(k, k.configField+"."+k.vlanField, k.firstVlan)
+	}
+	if k == &networkKind {
+		if _, ok := config["mcastGroup"]; !ok {
+			config["mcastGroup"] = "239.1.1.0"
+		}
+	}
+	o := &topDownObject{kind: k, fabric: fabric, name: name, body: obj, attachments: map[string]*attachment{}}
+	s.objects(k)[objectKey(fabric, name)] = o
+	return s.objectJSON(o), nil
+}
+
+func (s *Server) updateObject(k *kind, fabric, name string, body gjson.Result) (interface{}, *apiError) {
+	o, err := s.getObject(k, fabric, name)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := s.decodeObject(k, fabric, body)
+	if err != nil {
+		return nil, err
+	}
+	if id, ok := obj[k.idField]; ok && fmt.Sprint(id) != fmt.Sprint(o.body[k.idField]) {
+		return nil, errorf(http.StatusBadRequest, "%s cannot be modified", k.idField)
+	}
+	obj[k.nameField] = name
+	obj[k.idField] = o.body[k.idField]
+	config := obj[k.configField].(map[string]interface{})
+	if _, ok := config[k.vlanField]; !ok {
+		config[k.vlanField] = o.body[k.configField].(map[string]interface{})[k.vlanField]
+	}
+	o.body = obj
+	// Config changes must be redeployed to switches carrying the object.
+	for _, a := range o.attachments {
+		if a.configured && a.observe() == StateDeployed {
+			a.state = StatePending
+		}
+	}
+	return s.objectJSON(o), nil
+}
+
+func (s *Server) deleteObject(k *kind, fabric, name string) (interface{}, *apiError) {
+	o, err := s.getObject(k, fabric, name)
+	if err != nil {
+		return nil, err
+	}
+	for serial, a := range o.attachments {
+		if a.attached || a.configured || a.observe() == StateInProgress {
+			return nil, errorf(http.StatusBadRequest, "%s %s is attached to switch %s, detach and deploy before deleting", strings.TrimSuffix(k.nameField, "Name"), name, serial)
+		}
+	}
+	if k == &vrfKind {
+		for _, n := range s.networks {
+			if n.fabric == fabric && n.body["vrf"] == name {
+				return nil, errorf(http.StatusBadRequest, "VRF %s is in use by network %s", name, n.name)
+			}
+		}
+	}
+	delete(s.objects(k), objectKey(fabric, name))
+	return []interface{}{}, nil
+}
+
+func (s *Server) attachmentJSON(o *topDownObject, serial string) map[string]interface{} {
+	sw := s.switches[serial]
+	a, ok := o.attachments[serial]
+	if !ok {
+		a = &attachment{state: StateNA}
+	}
+	vlan := a.vlan
+	if vlan <= 0 {
+		vlan = gjson.Get(mustMarshal(o.body), o.kind.configField+"."+o.kind.vlanField).Int()
+	}
+	res := map[string]interface{}{
+		o.kind.nameField: o.name,
+		"fabricName":     o.fabric,
+		"switchSerialNo": serial,
+		"serialNumber":   serial,
+		"switchName":     sw.Hostname,
+		"switchRole":     sw.Role,
+		"ipAddress":      sw.IpAddress,
+		"vlanId":         vlan,
+		"isLanAttached":  a.attached,
+		"lanAttachState": a.observe(),
+	}
+	if o.kind == &networkKind {
+		res["portNames"] = a.switchPorts
+	}
+	if a.freeformConfig != "" {
+		res["freeformConfig"] = a.freeformConfig
+	}
+	if a.instanceValues != "" {
+		res["instanceValues"] = a.instanceValues
+	}
+	if a.extensionValues != "" {
+		res["extensionValues"] = a.extensionValues
+	}
+	return res
+}
+
+func (s *Server) getAttachments(k *kind, fabric string, names []string) (interface{}, *apiError) {
+	res := []interface{}{}
+	for _, name := range names {
+		o, err := s.getObject(k, fabric, name)
+		if err != nil {
+			return nil, err
+		}
+		list := []interface{}{}
+		for _, serial := range s.fabricSwitches(fabric) {
+			list = append(list, s.attachmentJSON(o, serial))
+		}
+		res = append(res, map[string]interface{}{
+			k.nameField:     name,
+			"lanAttachList": list,
+		})
+	}
+	return res, nil
+}
+
+func (s *Server) postAttachments(k *kind, fabric string, body gjson.Result) (interface{}, *apiError) {
+	res := map[string]interface{}{}
+	for _, entry := range body.Array() {
+		name := entry.Get(k.nameField).String()
+		o, err := s.getObject(k, fabric, name)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range entry.Get("lanAttachList").Array() {
+			serial := item.Get("serialNumber").String()
+			sw, ok := s.switches[serial]
+			if !ok || sw.Fabric != fabric {
+				return nil, errorf(http.StatusBadRequest, "Switch with serial number %s not found in fabric %s", serial, fabric)
+			}
+			a, ok := o.attachments[serial]
+			if !ok {
+				a = &attachment{state: StateNA}
+				o.attachments[serial] = a
+			}
+			current := a.observe()
+			if current == StateInProgress {
+				return nil, errorf(http.StatusConflict, "Deployment of %s %s is in progress on switch %s", strings.TrimSuffix(k.nameField, "Name"), name, serial)
+			}
+			key := fmt.Sprintf("%s-[%s/%s]", name, serial, sw.Hostname)
+			deployment := item.Get("deployment").Bool()
+			if !deployment {
+				if !a.attached && !a.configured {
+					res[key] = "SUCCESS Peer attach Reponse : Attachment already in detached state"
+					continue
+				}
+				a.attached = false
+				a.switchPorts = ""
+				if a.configured {
+					a.state = StatePending
+				} else {
+					a.state = StateNA
+				}
+				res[key] = "SUCCESS"
+				continue
+			}
+			changed := !a.attached
+			update := func(dst *string, path string) {
+				if v := item.Get(path); v.Exists() && v.String() != *dst {
+					*dst = v.String()
+					changed = true
+				}
+			}
+			if v := item.Get("vlan"); v.Exists() && v.Int() != a.vlan {
+				a.vlan = v.Int()
+				changed = true
+			}
+			update(&a.switchPorts, "switchPorts")
+			update(&a.detachSwitchPorts, "detachSwitchPorts")
+			update(&a.freeformConfig, "freeformConfig")
+			update(&a.instanceValues, "instanceValues")
+			update(&a.extensionValues, "extensionValues")
+			a.attached = true
+			if changed || current == StateNA {
+				a.state = StatePending
+			}
+			res[key] = "SUCCESS"
+		}
+	}
+	return res, nil
+}
+
+// deployObjects starts deployment of the named objects. If serials is
+// non-nil only attachments on those switches are deployed.
+func (s *Server) deployObjects(k *kind, fabric string, names []string, serials map[string]bool) (interface{}, *apiError) {
+	for _, name := range names {
+		o, err := s.getObject(k, fabric, strings.TrimSpace(name))
+		if err != nil {
+			return nil, err
+		}
+		for serial, a := range o.attachments {
+			if serials != nil && !serials[serial] {
+				continue
+			}
+			switch a.observe() {
+			case StatePending, StateOutOfSync, StateFailed:
+				a.state = StateInProgress
+				a.polls = s.DeployPolls
+			}
+		}
+	}
+	return map[string]interface{}{"status": "Deployment of " + k.collection + " has been initiated successfully"}, nil
+}
+
+// deploySwitches handles the per switch deploy APIs whose body maps serial
+// numbers to comma separated object names, independent of the fabric.
+func (s *Server) deploySwitches(k *kind, req request) (interface{}, *apiError) {
+	if req.method != http.MethodPost {
+		return nil, errorf(http.StatusNotFound, "No handler found for %s /%s", req.method, strings.Join(req.segments, "/"))
+	}
+	var apiErr *apiError
+	req.body.ForEach(func(serial, names gjson.Result) bool {
+		sw, ok := s.switches[serial.String()]
+		if !ok {
+			apiErr = errorf(http.StatusBadRequest, "Switch with serial number %s not found", serial.String())
+			return false
+		}
+		_, apiErr = s.deployObjects(k, sw.Fabric, strings.Split(names.String(), ","), map[string]bool{serial.String(): true})
+		return apiErr == nil
+	})
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	return map[string]interface{}{"status": "Deployment of " + k.collection + " has been initiated successfully"}, nil
+}
+
+func mustMarshal(v interface{}) string {
+	raw, _ := json.Marshal(v)
+	return string(raw)
+}
@@ -0,0 +1,168 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package ndfcmock
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+func (s *Server) routeVpcPair(req request) (interface{}, *apiError) {
+	seg := req.segments[3:]
+	switch {
+	case len(seg) == 0 && req.method == http.MethodGet:
+		return s.getVpcPair(req.query("serialNumber"))
+	case len(seg) == 0 && req.method == http.MethodPost:
+		return s.createVpcPair(req.body)
+	case len(seg) == 0 && req.method == http.MethodPut:
+		return s.updateVpcPair(req.body)
+	case len(seg) == 0 && req.method == http.MethodDelete:
+		return s.deleteVpcPair(req.query("serialNumber"))
+	case match(seg, "overview") && req.method == http.MethodGet:
+		return s.vpcPairOverview(req.query("serialNumber"))
+	}
+	return nil, errorf(http.StatusNotFound, "No handler found for %s /%s", req.method, strings.Join(req.segments, "/"))
+}
+
+func (s *Server) switchFabric(serial string) (interface{}, *apiError) {
+	sw, ok := s.switches[serial]
+	if !ok {
+		return nil, errorf(http.StatusNotFound, "Switch with serial number %s not found", serial)
+	}
+	return map[string]interface{}{"fabricName": sw.Fabric}, nil
+}
+
+// vpcPeers returns both switches of the vPC pair serial is part of.
+func (s *Server) vpcPeers(serial string) (*Switch, *Switch, *apiError) {
+	sw, ok := s.switches[serial]
+	if !ok || sw.vpcPeer == "" {
+		return nil, nil, errorf(http.StatusNotFound, "vPC pair not found for switch %s", serial)
+	}
+	return sw, s.switches[sw.vpcPeer], nil
+}
+
+func (s *Server) getVpcPair(serial string) (interface{}, *apiError) {
+	one, two, err := s.vpcPeers(serial)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"peerOneId":          one.SerialNumber,
+		"peerTwoId":          two.SerialNumber,
+		"useVirtualPeerlink": one.virtualPeerLink,
+		"vpcDomainId":        one.vpcDomain,
+	}, nil
+}
+
+func (s *Server) vpcPairOverview(serial string) (interface{}, *apiError) {
+	one, two, err := s.vpcPeers(serial)
+	if err != nil {
+		return nil, err
+	}
+	status := "consistent"
+	if !one.vpcDeployed || !two.vpcDeployed {
+		status = "NA"
+	}
+	return map[string]interface{}{
+		"overview": map[string]interface{}{
+			"peerOneId":         one.SerialNumber,
+			"peerTwoId":         two.SerialNumber,
+			"consistencyStatus": status,
+		},
+	}, nil
+}
+
+// vpcCandidates returns the two switches of a pairing request.
+func (s *Server) vpcCandidates(body gjson.Result) (*Switch, *Switch, *apiError) {
+	one, ok := s.switches[body.Get("peerOneId").String()]
+	if !ok {
+		return nil, nil, errorf(http.StatusBadRequest, "Switch with serial number %s not found", body.Get("peerOneId").String())
+	}
+	two, ok := s.switches[body.Get("peerTwoId").String()]
+	if !ok {
+		return nil, nil, errorf(http.StatusBadRequest, "Switch with serial number %s not found", body.Get("peerTwoId").String())
+	}
+	if one == two {
+		return nil, nil, errorf(http.StatusBadRequest, "A switch cannot be paired with itself")
+	}
+	if one.Fabric != two.Fabric {
+		return nil, nil, errorf(http.StatusBadRequest, "Switches %s and %s are not in the same fabric", one.SerialNumber, two.SerialNumber)
+	}
+	return one, two, nil
+}
+
+func (s *Server) createVpcPair(body gjson.Result) (interface{}, *apiError) {
+	one, two, err := s.vpcCandidates(body)
+	if err != nil {
+		return nil, err
+	}
+	for _, sw := range []*Switch{one, two} {
+		if sw.vpcPeer != "" {
+			return nil, errorf(http.StatusBadRequest, "Switch %s is already part of a vPC pair", sw.SerialNumber)
+		}
+	}
+	domain := 1
+	for _, sw := range s.switches {
+		if sw.Fabric == one.Fabric && sw.vpcDomain >= domain {
+			domain = sw.vpcDomain + 1
+		}
+	}
+	one.vpcPeer, two.vpcPeer = two.SerialNumber, one.SerialNumber
+	for _, sw := range []*Switch{one, two} {
+		sw.vpcDomain = domain
+		sw.virtualPeerLink = body.Get("useVirtualPeerlink").Bool()
+		sw.vpcDeployed = false
+	}
+	return s.getVpcPair(one.SerialNumber)
+}
+
+func (s *Server) updateVpcPair(body gjson.Result) (interface{}, *apiError) {
+	one, two, err := s.vpcCandidates(body)
+	if err != nil {
+		return nil, err
+	}
+	if one.vpcPeer != two.SerialNumber {
+		return nil, errorf(http.StatusBadRequest, "Switches %s and %s are not paired", one.SerialNumber, two.SerialNumber)
+	}
+	for _, sw := range []*Switch{one, two} {
+		sw.virtualPeerLink = body.Get("useVirtualPeerlink").Bool()
+		sw.vpcDeployed = false
+	}
+	return s.getVpcPair(one.SerialNumber)
+}
+
+func (s *Server) deleteVpcPair(serial string) (interface{}, *apiError) {
+	one, two, err := s.vpcPeers(serial)
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range s.interfaces {
+		if strings.Contains(i.serialNumber, "~") && contains(strings.Split(i.serialNumber, "~"), one.SerialNumber) {
+			return nil, errorf(http.StatusBadRequest, "vPC pair %s~%s still has vPC interface %s", one.SerialNumber, two.SerialNumber, i.ifName)
+		}
+	}
+	for _, sw := range []*Switch{one, two} {
+		sw.vpcPeer = ""
+		sw.vpcDomain = 0
+		sw.virtualPeerLink = false
+		sw.vpcDeployed = false
+	}
+	return []interface{}{}, nil
+}
@@ -34,13 +34,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/netascode/go-nd"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
 )
 
 
 
-const NDFC_BASEPATH = "/appcenter/cisco/ndfc/api/v1"
-
 // NdfcProvider defines the provider implementation.
 type NdfcProvider struct {
 	// version is set to the provider version on release, "dev" when the
@@ -58,14 +56,10 @@ type NdfcProviderModel struct {
 	Insecure types.Bool   `tfsdk:"insecure"`
 	Retries  types.Int64  `tfsdk:"retries"`
 }
-type NdfcClient struct {
-	client      *nd.Client
-	updateMutex *sync.Mutex
-}
 
 // NdfcProviderData describes the data maintained by the provider.
 type NdfcProviderData struct {
-	Client      *nd.Client
+	Client      *ndfc.Client
 	UpdateMutex *sync.Mutex
 }
 
@@ -256,10 +250,10 @@ func (p *NdfcProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		retries = config.Retries.ValueInt64()
 	}
 
-	// Create a new ND client and set it to the provider client
-	c, err := nd.NewClient(url, NDFC_BASEPATH, username, password, domain, insecure, nd.MaxRetries(int(retries)))
+	// Create a new NDFC client and set it to the provider client
+	c, err := ndfc.NewClient(url, username, password, domain, insecure, int(retries))
 	if err != nil {
-		log.Printf("New client creation error : %s %s", url, NDFC_BASEPATH)
+		log.Printf("New client creation error : %s %s", url, ndfc.BasePath)
 		resp.Diagnostics.AddError(
 			"Unable to create client",
 			"Unable to create nd client:\n\n"+err.Error(),
@@ -267,30 +261,46 @@ func (p *NdfcProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
-	data := NdfcProviderData{Client: &c, UpdateMutex: &sync.Mutex{}}
+	data := NdfcProviderData{Client: c, UpdateMutex: &sync.Mutex{}}
 	resp.DataSourceData = &data
 	resp.ResourceData = &data
 }
 
-func NewNdfcClient() resource.Resource {
-	return &NdfcClient{}
-}
-
 func (p *NdfcProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewFabricResource,
+		NewInventoryDevicesResource,
+		NewVpcPairResource,
+		NewDeployResource,
 		NewInterfaceEthernetResource,
 		NewInterfaceLoopbackResource,
+		NewInterfacePortChannelResource,
+		NewInterfaceSubinterfaceResource,
+		NewInterfaceBreakoutResource,
+		NewInterfaceNVEResource,
+		NewInterfaceVPCResource,
 		NewInterfaceVlanResource,
+		NewInterfacesResource,
 		NewNetworkResource,
-		NewNdfcClient,
+		NewNetworkAttachmentResource,
+		NewVRFResource,
+		NewVRFAttachmentResource,
 	}
 }
 
 func (p *NdfcProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewFabricDataSource,
+		NewSwitchesDataSource,
+		NewConfigPreviewDataSource,
 		NewInterfaceEthernetDataSource,
 		NewInterfaceLoopbackDataSource,
+		NewInterfacePortChannelDataSource,
+		NewInterfaceSubinterfaceDataSource,
+		NewInterfaceNVEDataSource,
+		NewInterfaceVPCDataSource,
 		NewInterfaceVlanDataSource,
+		NewInterfacesDataSource,
 		NewNetworkDataSource,
 		NewVRFDataSource,
 	}
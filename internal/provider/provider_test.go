@@ -18,11 +18,15 @@
 package provider
 
 import (
+	"context"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfcmock"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -33,6 +37,31 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"ndfc": providerserver.NewProtocol6WithError(New("test")()),
 }
 
+// testAccMock is the NDFC mock the acceptance tests run against, or nil if
+// they run against a Nexus Dashboard.
+var testAccMock *ndfcmock.Server
+
+// TestMain starts the in-repo NDFC mock when NDFC_MOCK is set and points the
+// provider at it through the usual environment variables, so that the
+// acceptance tests can run without a Nexus Dashboard.
+func TestMain(m *testing.M) {
+	if os.Getenv("NDFC_MOCK") == "" {
+		os.Exit(m.Run())
+	}
+	server := ndfcmock.NewServer()
+	testAccMock = server
+	os.Setenv("NDFC_URL", server.URL)
+	os.Setenv("NDFC_USERNAME", ndfcmock.DefaultUsername)
+	os.Setenv("NDFC_PASSWORD", ndfcmock.DefaultPassword)
+	os.Setenv("NDFC_RETRIES", "0")
+	// the mock settles deployments within a few polls
+	ndfc.PollInterval = 100 * time.Millisecond
+	ndfc.BatchWindow = 100 * time.Millisecond
+	code := m.Run()
+	server.Close()
+	os.Exit(code)
+}
+
 func testAccPreCheck(t *testing.T) {
 	// You can add code here to run prior to any test case execution, for example assertions
 	// about the appropriate environment variables being set are common to see in a pre-check
@@ -47,3 +76,29 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("NDFC_URL env variable must be set for acceptance tests")
 	}
 }
+
+// testAccPost sends a request to NDFC outside of Terraform, e.g. to change
+// attachments in a PreConfig function to test drift detection.
+func testAccPost(t *testing.T, path, body string) {
+	c, err := ndfc.NewClient(os.Getenv("NDFC_URL"), os.Getenv("NDFC_USERNAME"), os.Getenv("NDFC_PASSWORD"), "", true, 0)
+	if err != nil {
+		t.Fatalf("create client: %v", err)
+	}
+	if _, err := c.Post(context.Background(), path, body); err != nil {
+		t.Fatalf("POST %v: %v", path, err)
+	}
+}
+
+// testAccSetAttachmentState sets the state of an attachment in the mock, e.g.
+// to OUT-OF-SYNC as if the switch was changed outside of NDFC.
+func testAccSetAttachmentState(t *testing.T, collection, name, serial, state string) {
+	if !testAccMock.SetAttachmentState(collection, "CML", name, serial, state) {
+		t.Fatalf("%v %v is not attached to %v", collection, name, serial)
+	}
+}
+
+// testAccSkipWithoutMock skips test steps that change NDFC in ways only the
+// mock supports.
+func testAccSkipWithoutMock() (bool, error) {
+	return testAccMock == nil, nil
+}
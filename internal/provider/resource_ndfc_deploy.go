@@ -0,0 +1,375 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/helpers"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &DeployResource{}
+
+func NewDeployResource() resource.Resource {
+	return &DeployResource{}
+}
+
+type DeployResource struct {
+	client      *ndfc.Client
+	updateMutex *sync.Mutex
+}
+
+func (r *DeployResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deploy"
+}
+
+func (r *DeployResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: helpers.NewAttributeDescription("This resource deploys the pending configuration of switches or interfaces when it is created, e.g. changes staged with `deploy = false`. Change `triggers` to deploy again. Destroying the resource does not change any configuration.").String,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The id of the object",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+			"serial_numbers": schema.SetAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Serial numbers of the switches to deploy all pending configuration of").String,
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.AtLeastOneOf(path.MatchRoot("interfaces")),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"interfaces": schema.SetAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Interfaces to deploy, in the format `<serial_number>:<interface_name>`. Example: `9DBYO6WQJ46:Ethernet1/10`").String,
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(regexp.MustCompile(`^[^:]+:[^:]+$`), "must have the format `<serial_number>:<interface_name>`")),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Arbitrary values that trigger a new deployment when changed").String,
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"config_status": schema.MapAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Config compliance status of the deployed switches and interfaces, keyed by serial number or `<serial_number>:<interface_name>`. Example: `In-Sync`").String,
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DeployResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*NdfcProviderData).Client
+	r.updateMutex = req.ProviderData.(*NdfcProviderData).UpdateMutex
+}
+
+// fabricSwitches groups serials by the fabric the switches are part of.
+func (r *DeployResource) fabricSwitches(ctx context.Context, serials []string) (map[string][]string, error) {
+	res := map[string][]string{}
+	for _, serial := range serials {
+		fabric, err := r.client.GetSwitchFabric(ctx, serial)
+		if err != nil {
+			return nil, err
+		}
+		res[fabric] = append(res[fabric], serial)
+	}
+	return res, nil
+}
+
+// readConfigStatus returns the config compliance status of the switches and
+// interfaces of data. Switches and interfaces that no longer exist are
+// omitted.
+func (r *DeployResource) readConfigStatus(ctx context.Context, data Deploy) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	status := map[string]string{}
+
+	fabrics := map[string][]string{}
+	for _, serial := range data.serialNumbers(ctx) {
+		fabric, err := r.client.GetSwitchFabric(ctx, serial)
+		if err != nil {
+			if ndfc.IsNotFound(err) {
+				continue
+			}
+			diags.Append(helpers.ClientError(fmt.Sprintf("Failed to look up fabric of switch %s", serial), err, nil))
+			return nil, diags
+		}
+		fabrics[fabric] = append(fabrics[fabric], serial)
+	}
+	for fabric, serials := range fabrics {
+		switches, err := r.client.GetSwitches(ctx, fabric)
+		if err != nil {
+			diags.Append(helpers.ClientError("Failed to retrieve switches (GET)", err, nil))
+			return nil, diags
+		}
+		for _, sw := range switches {
+			for _, serial := range serials {
+				if sw.SerialNumber == serial {
+					status[serial] = sw.ConfigStatus
+				}
+			}
+		}
+	}
+
+	for _, key := range data.interfaces(ctx) {
+		res, err := r.client.GetInterface(ctx, interfacesRef(key))
+		if err != nil {
+			if ndfc.IsNotFound(err) {
+				continue
+			}
+			diags.Append(helpers.ClientError("Failed to retrieve object (GET)", err, nil))
+			return nil, diags
+		}
+		status[key] = res.Get("0.interfaces.0.complianceStatus").String()
+	}
+	return status, diags
+}
+
+// waitConfigStatus polls the config compliance status of the switches and
+// interfaces of data until none of them is out of sync, pending or in
+// progress any more. NDFC deploys asynchronously, the status read right
+// after a deployment is usually still the one from before. It fails if a
+// deployment fails or does not complete in time.
+func (r *DeployResource) waitConfigStatus(ctx context.Context, data Deploy) (map[string]string, diag.Diagnostics) {
+	var status map[string]string
+	var diags diag.Diagnostics
+	var pending, failed []string
+	err := ndfc.Poll(ctx, func(ctx context.Context) (bool, error) {
+		status, diags = r.readConfigStatus(ctx, data)
+		if diags.HasError() {
+			return true, nil
+		}
+		pending, failed = nil, nil
+		for key, s := range status {
+			switch lower := strings.ToLower(s); {
+			case strings.Contains(lower, "fail") || strings.Contains(lower, "error"):
+				failed = append(failed, fmt.Sprintf("%s (%s)", key, s))
+			case strings.EqualFold(s, ndfc.ConfigOutOfSync) || strings.Contains(lower, "pending") || strings.Contains(lower, "progress"):
+				pending = append(pending, fmt.Sprintf("%s (%s)", key, s))
+			}
+		}
+		if len(pending) > 0 && len(failed) == 0 {
+			tflog.Debug(ctx, fmt.Sprintf("%s: waiting for %v", data.Id.ValueString(), pending))
+		}
+		return len(pending) == 0 || len(failed) > 0, nil
+	})
+	if diags.HasError() {
+		return nil, diags
+	}
+	if err != nil {
+		sort.Strings(pending)
+		diags.Append(helpers.WaitError(fmt.Sprintf("Failed to wait for deployment, not in sync: %s", strings.Join(pending, ", ")), err))
+		return nil, diags
+	}
+	if len(failed) > 0 {
+		sort.Strings(failed)
+		diags.AddError("Client Error", fmt.Sprintf("Deployment failed: %s", strings.Join(failed, ", ")))
+		return nil, diags
+	}
+	return status, diags
+}
+
+// deploy deploys the switches with serial numbers serials and the interfaces
+// at keys. It holds the update lock while the deployments are started, but
+// not while waiting for them to complete.
+func (r *DeployResource) deploy(ctx context.Context, serials, keys []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	r.updateMutex.Lock()
+	defer r.updateMutex.Unlock()
+
+	// Deploy switches
+	fabrics, err := r.fabricSwitches(ctx, serials)
+	if err != nil {
+		diags.Append(helpers.ClientError("Failed to look up fabric of switches", err, nil))
+		return diags
+	}
+	for fabric, serials := range fabrics {
+		err := r.client.DeploySwitches(ctx, fabric, serials...)
+		if err != nil {
+			diags.Append(helpers.ClientError(fmt.Sprintf("Failed to deploy switches (%s)", strings.Join(serials, ", ")), err, nil))
+			return diags
+		}
+	}
+
+	// Deploy interfaces
+	if len(keys) > 0 {
+		diags.Append(helpers.DeployInterfaces(ctx, r.client, interfacesRefs(keys)...)...)
+	}
+	return diags
+}
+
+func (r *DeployResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Deploy
+
+	// Read plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	serials := plan.serialNumbers(ctx)
+	keys := plan.interfaces(ctx)
+	plan.Id = types.StringValue(strings.Join(append(serials, keys...), ","))
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
+
+	resp.Diagnostics.Append(r.deploy(ctx, serials, keys)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status, diags := r.waitConfigStatus(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.fromConfigStatus(status)
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Create finished successfully", plan.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the config compliance status, it never triggers a new
+// deployment.
+func (r *DeployResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Deploy
+
+	// Read state
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
+
+	status, diags := r.readConfigStatus(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.fromConfigStatus(status)
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", state.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update only applies timeouts, all other attributes require replacement.
+func (r *DeployResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Deploy
+
+	// Read plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Update finished successfully", plan.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete only removes the resource from the state, deployed configuration
+// stays on the switches.
+func (r *DeployResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Deploy
+
+	// Read state
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Delete finished successfully", state.Id.ValueString()))
+
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,65 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNdfcDeploy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNdfcDeployConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ndfc_deploy.test", "config_status.%", "2"),
+					resource.TestCheckResourceAttr("ndfc_deploy.test", "config_status.9DBYO6WQJ46:Ethernet1/14", "In-Sync"),
+					resource.TestCheckResourceAttr("ndfc_deploy.test", "config_status.9RB5Y9BFNTU", "In-Sync"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNdfcDeployConfig = `
+
+resource "ndfc_interface_ethernet" "test" {
+	serial_number = "9DBYO6WQJ46"
+	interface_name = "Ethernet1/14"
+	policy = "int_access_host"
+	access_vlan = 500
+	deploy = false
+}
+
+resource "ndfc_interface_ethernet" "test2" {
+	serial_number = "9RB5Y9BFNTU"
+	interface_name = "Ethernet1/14"
+	policy = "int_access_host"
+	access_vlan = 500
+	deploy = false
+}
+
+resource "ndfc_deploy" "test" {
+	serial_numbers = [ndfc_interface_ethernet.test2.serial_number]
+	interfaces = ["${ndfc_interface_ethernet.test.serial_number}:${ndfc_interface_ethernet.test.interface_name}"]
+}
+`
@@ -0,0 +1,306 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/helpers"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &InterfaceBreakoutResource{}
+var _ resource.ResourceWithImportState = &InterfaceBreakoutResource{}
+
+func NewInterfaceBreakoutResource() resource.Resource {
+	return &InterfaceBreakoutResource{}
+}
+
+type InterfaceBreakoutResource struct {
+	client      *ndfc.Client
+	updateMutex *sync.Mutex
+}
+
+func (r *InterfaceBreakoutResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_interface_breakout"
+}
+
+func (r *InterfaceBreakoutResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: helpers.NewAttributeDescription("This resource can break out a physical interface into multiple child interfaces.").String,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The id of the object",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+			"serial_number": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Serial number of switch to configure").String,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"interface_name": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Name of the physical interface to break out. Example: `Ethernet1/49`").String,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"breakout_map": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Speed and number of the child interfaces. Examples: `10g-4x`, `25g-4x`, `50g-2x`, `100g-4x`").String,
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\d+g-\dx$`), "must be a breakout map like `25g-4x`"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"interfaces": schema.ListAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Names of the child interfaces, e.g. `Ethernet1/49/1`").String,
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *InterfaceBreakoutResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*NdfcProviderData).Client
+	r.updateMutex = req.ProviderData.(*NdfcProviderData).UpdateMutex
+}
+
+func (r *InterfaceBreakoutResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan InterfaceBreakout
+
+	// Read plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	plan.Id = types.StringValue(plan.SerialNumber.ValueString() + "/" + plan.InterfaceName.ValueString())
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
+
+	children, err := plan.children()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("breakout_map"), "Invalid Attribute Value", err.Error())
+		return
+	}
+
+	err = r.client.BreakoutInterface(ctx, plan.ref(), plan.BreakoutMap.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ClientError("Failed to break out interface (POST)", err, nil))
+		return
+	}
+
+	// Deploy switch, the wait below does not need to hold the lock
+	r.updateMutex.Lock()
+	diags = helpers.DeploySwitches(ctx, r.client, plan.SerialNumber.ValueString())
+	r.updateMutex.Unlock()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The child interfaces appear once the switch has been rediscovered
+	err = r.client.WaitForInterfaces(ctx, plan.SerialNumber.ValueString(), children...)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.WaitError("Failed to wait for child interfaces", err))
+		return
+	}
+	plan.fromChildren(children)
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Create finished successfully", plan.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *InterfaceBreakoutResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state InterfaceBreakout
+
+	// Read state
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
+
+	children, err := state.children()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("breakout_map"), "Invalid Attribute Value", err.Error())
+		return
+	}
+
+	// The breakout is gone if its child interfaces no longer exist
+	for _, child := range children {
+		_, err := r.client.GetInterface(ctx, ndfc.InterfaceRef{SerialNumber: state.SerialNumber.ValueString(), IfName: child})
+		if err != nil {
+			if ndfc.IsNotFound(err) {
+				resp.State.RemoveResource(ctx)
+				return
+			} else {
+				resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve object (GET)", err, nil))
+				return
+			}
+		}
+	}
+	state.fromChildren(children)
+	state.Id = types.StringValue(state.SerialNumber.ValueString() + "/" + state.InterfaceName.ValueString())
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", state.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update only applies timeouts, all other attributes require replacement.
+func (r *InterfaceBreakoutResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan InterfaceBreakout
+
+	// Read plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Update finished successfully", plan.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *InterfaceBreakoutResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state InterfaceBreakout
+
+	// Read state
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
+
+	err := r.client.UnbreakoutInterface(ctx, state.ref())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ClientError("Failed to remove breakout (DELETE)", err, nil))
+		return
+	}
+
+	// Deploy switch, the wait below does not need to hold the lock
+	r.updateMutex.Lock()
+	diags = helpers.DeploySwitches(ctx, r.client, state.SerialNumber.ValueString())
+	r.updateMutex.Unlock()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = r.client.WaitForInterfaces(ctx, state.SerialNumber.ValueString(), state.InterfaceName.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.WaitError("Failed to wait for physical interface", err))
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Delete finished successfully", state.Id.ValueString()))
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *InterfaceBreakoutResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	idParts := strings.Split(req.ID, ":")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: '<serial_number>:<interface_name>:<breakout_map>'. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial_number"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("interface_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("breakout_map"), idParts[2])...)
+}
@@ -0,0 +1,63 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNdfcInterfaceBreakout(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNdfcInterfaceBreakoutConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ndfc_interface_breakout.test", "interfaces.#", "4"),
+					resource.TestCheckResourceAttr("ndfc_interface_breakout.test", "interfaces.0", "Ethernet1/49/1"),
+					resource.TestCheckResourceAttr("ndfc_interface_ethernet.test", "interface_name", "Ethernet1/49/1"),
+					resource.TestCheckResourceAttr("ndfc_interface_ethernet.test", "access_vlan", "500"),
+				),
+			},
+			{
+				ResourceName:  "ndfc_interface_breakout.test",
+				ImportState:   true,
+				ImportStateId: "9DBYO6WQJ46:Ethernet1/49:25g-4x",
+			},
+		},
+	})
+}
+
+const testAccNdfcInterfaceBreakoutConfig = `
+
+resource "ndfc_interface_breakout" "test" {
+	serial_number = "9DBYO6WQJ46"
+	interface_name = "Ethernet1/49"
+	breakout_map = "25g-4x"
+}
+
+resource "ndfc_interface_ethernet" "test" {
+	serial_number = ndfc_interface_breakout.test.serial_number
+	interface_name = ndfc_interface_breakout.test.interfaces[0]
+	policy = "int_access_host"
+	access_vlan = 500
+}
+`
@@ -26,6 +26,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -38,8 +39,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/netascode/go-nd"
 	"github.com/netascode/terraform-provider-ndfc/internal/provider/helpers"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
 )
 
 //template:end imports
@@ -55,7 +56,7 @@ func NewInterfaceEthernetResource() resource.Resource {
 }
 
 type InterfaceEthernetResource struct {
-	client      *nd.Client
+	client      *ndfc.Client
 	updateMutex *sync.Mutex
 }
 
@@ -76,6 +77,12 @@ func (r *InterfaceEthernetResource) Schema(ctx context.Context, req resource.Sch
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 			"serial_number": schema.StringAttribute{
 				MarkdownDescription: helpers.NewAttributeDescription("Serial number of switch to configure").String,
 				Optional:            true,
@@ -90,6 +97,18 @@ func (r *InterfaceEthernetResource) Schema(ctx context.Context, req resource.Sch
 				Computed:            true,
 				Default:             stringdefault.StaticString("int_trunk_host"),
 			},
+			"destroy_policy": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Policy the interface is reset to, with its default values, when the resource is destroyed. Examples: `int_trunk_host`, `int_access_host`").AddDefaultValueDescription("int_trunk_host").String,
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("int_trunk_host"),
+			},
+			"deploy": schema.BoolAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Deploy the configuration to the switch. If `false`, changes are only staged in NDFC and can be deployed later, e.g. with `ndfc_deploy`").AddDefaultValueDescription("true").String,
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
 			"bpdu_guard": schema.StringAttribute{
 				MarkdownDescription: helpers.NewAttributeDescription("Enable spanning-tree bpduguard: true='enable', false='disable', no='return to default settings'").AddStringEnumDescription("true", "false", "no").AddDefaultValueDescription("true").String,
 				Optional:            true,
@@ -208,24 +227,34 @@ func (r *InterfaceEthernetResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
 
 	// Create object
 	body := plan.toBody(ctx)
 
-	res, err := r.client.Put(plan.getPath(), body)
+	_, err := r.client.Put(ctx, plan.getPath(), body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to configure object (PUT), got error: %s, %s", err, res.String()))
+		resp.Diagnostics.Append(helpers.ClientError("Failed to configure object (PUT)", err, plan.fieldPaths()))
 		return
 	}
 
 	// Deploy interface
-	r.updateMutex.Lock()
-	defer r.updateMutex.Unlock()
-	diags = helpers.DeployInterface(ctx, r.client, plan.SerialNumber.ValueString(), plan.InterfaceName.ValueString())
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	if plan.Deploy.ValueBool() {
+		r.updateMutex.Lock()
+		defer r.updateMutex.Unlock()
+		diags = helpers.DeployInterface(ctx, r.client, plan.SerialNumber.ValueString(), plan.InterfaceName.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	plan.Id = types.StringValue(plan.SerialNumber.ValueString() + "/" + plan.InterfaceName.ValueString())
@@ -246,15 +275,23 @@ func (r *InterfaceEthernetResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
 
-	res, err := r.client.Get(fmt.Sprintf("%v?serialNumber=%v&ifName=%v", state.getPath(), state.SerialNumber.ValueString(), state.InterfaceName.ValueString()))
+	res, err := r.client.Get(ctx, fmt.Sprintf("%v?serialNumber=%v&ifName=%v", state.getPath(), state.SerialNumber.ValueString(), state.InterfaceName.ValueString()))
 	if err != nil {
-		if strings.Contains(err.Error(), "StatusCode 400") || strings.Contains(err.Error(), "StatusCode 500") {
+		if ndfc.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		} else {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, res.String()))
+			resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve object (GET)", err, nil))
 			return
 		}
 	}
@@ -277,22 +314,32 @@ func (r *InterfaceEthernetResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
 
 	body := plan.toBody(ctx)
-	res, err := r.client.Put(plan.getPath(), body)
+	_, err := r.client.Put(ctx, plan.getPath(), body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to configure object (PUT), got error: %s, %s", err, res.String()))
+		resp.Diagnostics.Append(helpers.ClientError("Failed to configure object (PUT)", err, plan.fieldPaths()))
 		return
 	}
 
 	// Deploy interface
-	r.updateMutex.Lock()
-	defer r.updateMutex.Unlock()
-	diags = helpers.DeployInterface(ctx, r.client, plan.SerialNumber.ValueString(), plan.InterfaceName.ValueString())
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	if plan.Deploy.ValueBool() {
+		r.updateMutex.Lock()
+		defer r.updateMutex.Unlock()
+		diags = helpers.DeployInterface(ctx, r.client, plan.SerialNumber.ValueString(), plan.InterfaceName.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Update finished successfully", plan.Id.ValueString()))
@@ -311,8 +358,41 @@ func (r *InterfaceEthernetResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
 
+	// Physical interfaces cannot be removed, reset them to the destroy policy
+	// and its default values
+	defaults, err := r.client.GetTemplateDefaults(ctx, state.getDestroyPolicy())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve destroy policy (GET)", err, nil))
+		return
+	}
+	body := withNvPairDefaults(state.toDestroyBody(ctx), defaults)
+	_, err = r.client.Put(ctx, state.getPath(), body)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ClientError("Failed to reset object (PUT)", err, nil))
+		return
+	}
+
+	// Deploy interface
+	if state.Deploy.ValueBool() {
+		r.updateMutex.Lock()
+		defer r.updateMutex.Unlock()
+		diags = helpers.DeployInterface(ctx, r.client, state.SerialNumber.ValueString(), state.InterfaceName.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	tflog.Debug(ctx, fmt.Sprintf("%s: Delete finished successfully", state.Id.ValueString()))
 
 	resp.State.RemoveResource(ctx)
@@ -100,3 +100,49 @@ resource "ndfc_interface_ethernet" "test" {
 `
 
 //template:end testAccConfigAll
+
+func TestAccNdfcInterfaceEthernetDestroyPolicy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNdfcInterfaceEthernetConfigDestroyPolicy,
+			},
+			// The data source is read before the resource is destroyed, the
+			// reset interface is only read in the next step.
+			{
+				Config: testAccNdfcInterfaceEthernetConfigDestroyed,
+			},
+			{
+				Config: testAccNdfcInterfaceEthernetConfigDestroyed,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ndfc_interface_ethernet.test", "policy", "int_trunk_host"),
+					resource.TestCheckResourceAttr("data.ndfc_interface_ethernet.test", "allowed_vlans", "none"),
+					resource.TestCheckResourceAttr("data.ndfc_interface_ethernet.test", "mtu", "jumbo"),
+					resource.TestCheckResourceAttr("data.ndfc_interface_ethernet.test", "admin_state", "true"),
+					resource.TestCheckNoResourceAttr("data.ndfc_interface_ethernet.test", "access_vlan"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNdfcInterfaceEthernetConfigDestroyPolicy = `
+resource "ndfc_interface_ethernet" "test" {
+	serial_number = "9DBYO6WQJ46"
+	interface_name = "Ethernet1/14"
+	policy = "int_access_host"
+	mtu = "default"
+	access_vlan = 500
+	admin_state = false
+	destroy_policy = "int_trunk_host"
+}
+`
+
+const testAccNdfcInterfaceEthernetConfigDestroyed = `
+data "ndfc_interface_ethernet" "test" {
+	serial_number = "9DBYO6WQJ46"
+	interface_name = "Ethernet1/14"
+}
+`
@@ -26,6 +26,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -35,8 +36,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/netascode/go-nd"
 	"github.com/netascode/terraform-provider-ndfc/internal/provider/helpers"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
 	"github.com/tidwall/sjson"
 )
 
@@ -53,7 +54,7 @@ func NewInterfaceLoopbackResource() resource.Resource {
 }
 
 type InterfaceLoopbackResource struct {
-	client      *nd.Client
+	client      *ndfc.Client
 	updateMutex *sync.Mutex
 }
 
@@ -74,6 +75,12 @@ func (r *InterfaceLoopbackResource) Schema(ctx context.Context, req resource.Sch
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 			"serial_number": schema.StringAttribute{
 				MarkdownDescription: helpers.NewAttributeDescription("Serial number of switch to configure").String,
 				Optional:            true,
@@ -88,6 +95,12 @@ func (r *InterfaceLoopbackResource) Schema(ctx context.Context, req resource.Sch
 				Computed:            true,
 				Default:             stringdefault.StaticString("int_loopback"),
 			},
+			"deploy": schema.BoolAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Deploy the configuration to the switch. If `false`, changes are only staged in NDFC and can be deployed later, e.g. with `ndfc_deploy`").AddDefaultValueDescription("true").String,
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
 			"vrf": schema.StringAttribute{
 				MarkdownDescription: helpers.NewAttributeDescription("Interface VRF name, default VRF if not specified").String,
 				Optional:            true,
@@ -145,24 +158,34 @@ func (r *InterfaceLoopbackResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
 
 	// Create object
 	body := plan.toBody(ctx)
 
-	res, err := r.client.Post(plan.getPath(), body)
+	_, err := r.client.Post(ctx, plan.getPath(), body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to configure object (PUT), got error: %s, %s", err, res.String()))
+		resp.Diagnostics.Append(helpers.ClientError("Failed to configure object (POST)", err, plan.fieldPaths()))
 		return
 	}
 
 	// Deploy interface
-	r.updateMutex.Lock()
-	defer r.updateMutex.Unlock()
-	diags = helpers.DeployInterface(ctx, r.client, plan.SerialNumber.ValueString(), plan.InterfaceName.ValueString())
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	if plan.Deploy.ValueBool() {
+		r.updateMutex.Lock()
+		defer r.updateMutex.Unlock()
+		diags = helpers.DeployInterface(ctx, r.client, plan.SerialNumber.ValueString(), plan.InterfaceName.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	plan.Id = types.StringValue(plan.SerialNumber.ValueString() + "/" + plan.InterfaceName.ValueString())
@@ -183,15 +206,23 @@ func (r *InterfaceLoopbackResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
 
-	res, err := r.client.Get(fmt.Sprintf("%v?serialNumber=%v&ifName=%v", state.getPath(), state.SerialNumber.ValueString(), state.InterfaceName.ValueString()))
+	res, err := r.client.Get(ctx, fmt.Sprintf("%v?serialNumber=%v&ifName=%v", state.getPath(), state.SerialNumber.ValueString(), state.InterfaceName.ValueString()))
 	if err != nil {
-		if strings.Contains(err.Error(), "StatusCode 400") || strings.Contains(err.Error(), "StatusCode 500") {
+		if ndfc.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		} else {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, res.String()))
+			resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve object (GET)", err, nil))
 			return
 		}
 	}
@@ -214,22 +245,32 @@ func (r *InterfaceLoopbackResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
 
 	body := plan.toBody(ctx)
-	res, err := r.client.Put(plan.getPath(), body)
+	_, err := r.client.Put(ctx, plan.getPath(), body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to configure object (PUT), got error: %s, %s", err, res.String()))
+		resp.Diagnostics.Append(helpers.ClientError("Failed to configure object (PUT)", err, plan.fieldPaths()))
 		return
 	}
 
 	// Deploy interface
-	r.updateMutex.Lock()
-	defer r.updateMutex.Unlock()
-	diags = helpers.DeployInterface(ctx, r.client, plan.SerialNumber.ValueString(), plan.InterfaceName.ValueString())
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	if plan.Deploy.ValueBool() {
+		r.updateMutex.Lock()
+		defer r.updateMutex.Unlock()
+		diags = helpers.DeployInterface(ctx, r.client, plan.SerialNumber.ValueString(), plan.InterfaceName.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Update finished successfully", plan.Id.ValueString()))
@@ -248,23 +289,33 @@ func (r *InterfaceLoopbackResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
 
 	body, _ := sjson.Set("", "0.serialNumber", state.SerialNumber.ValueString())
 	body, _ = sjson.Set(body, "0.ifName", state.InterfaceName.ValueString())
-	res, err := r.client.Delete(state.getPath(), body)
+	_, err := r.client.Delete(ctx, state.getPath(), body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to delete object (DELETE), got error: %s, %s", err, res.String()))
+		resp.Diagnostics.Append(helpers.ClientError("Failed to delete object (DELETE)", err, nil))
 		return
 	}
 
 	// Deploy interface
-	r.updateMutex.Lock()
-	defer r.updateMutex.Unlock()
-	diags = helpers.DeployInterface(ctx, r.client, state.SerialNumber.ValueString(), state.InterfaceName.ValueString())
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	if state.Deploy.ValueBool() {
+		r.updateMutex.Lock()
+		defer r.updateMutex.Unlock()
+		diags = helpers.DeployInterface(ctx, r.client, state.SerialNumber.ValueString(), state.InterfaceName.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Delete finished successfully", state.Id.ValueString()))
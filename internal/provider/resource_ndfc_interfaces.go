@@ -0,0 +1,450 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/helpers"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
+	"github.com/tidwall/gjson"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &InterfacesResource{}
+var _ resource.ResourceWithImportState = &InterfacesResource{}
+
+func NewInterfacesResource() resource.Resource {
+	return &InterfacesResource{}
+}
+
+type InterfacesResource struct {
+	client      *ndfc.Client
+	updateMutex *sync.Mutex
+}
+
+func (r *InterfacesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_interfaces"
+}
+
+func (r *InterfacesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: helpers.NewAttributeDescription("This resource can manage many ethernet interfaces of one or more switches at once. The interfaces are configured with one request per policy and deployed with a single request.").String,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The id of the object",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+			"destroy_policy": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Policy the interfaces are reset to, with its default values, when they are removed from the resource or the resource is destroyed. Examples: `int_trunk_host`, `int_access_host`").AddDefaultValueDescription("int_trunk_host").String,
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("int_trunk_host"),
+			},
+			"deploy": schema.BoolAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Deploy the configuration to the switches. If `false`, changes are only staged in NDFC and can be deployed later, e.g. with `ndfc_deploy`").AddDefaultValueDescription("true").String,
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"interfaces": schema.MapNestedAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Ethernet interfaces, keyed by `<serial_number>:<interface_name>`. Example: `9DBYO6WQJ46:Ethernet1/10`").String,
+				Required:            true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(regexp.MustCompile(`^[^:]+:[^:]+$`), "must have the format `<serial_number>:<interface_name>`")),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"policy": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Name of the policy. Examples: `int_trunk_host`, `int_access_host`").AddDefaultValueDescription("int_trunk_host").String,
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("int_trunk_host"),
+						},
+						"bpdu_guard": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Enable spanning-tree bpduguard: true='enable', false='disable', no='return to default settings'").AddStringEnumDescription("true", "false", "no").AddDefaultValueDescription("true").String,
+							Optional:            true,
+							Computed:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("true", "false", "no"),
+							},
+							Default: stringdefault.StaticString("true"),
+						},
+						"port_type_fast": schema.BoolAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Enable spanning-tree edge port behavior").AddDefaultValueDescription("true").String,
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+						"mtu": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("MTU for the interface").AddStringEnumDescription("default", "jumbo").AddDefaultValueDescription("jumbo").String,
+							Optional:            true,
+							Computed:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("default", "jumbo"),
+							},
+							Default: stringdefault.StaticString("jumbo"),
+						},
+						"speed": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Interface speed").AddStringEnumDescription("Auto", "10Mb", "100Mb", "1Gb", "2.5Gb", "5Gb", "10Gb", "25Gb", "40Gb", "50Gb", "100Gb", "200Gb", "400Gb").AddDefaultValueDescription("Auto").String,
+							Optional:            true,
+							Computed:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("Auto", "10Mb", "100Mb", "1Gb", "2.5Gb", "5Gb", "10Gb", "25Gb", "40Gb", "50Gb", "100Gb", "200Gb", "400Gb"),
+							},
+							Default: stringdefault.StaticString("Auto"),
+						},
+						"access_vlan": schema.Int64Attribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Access VLAN ID").AddIntegerRangeDescription(1, 4094).String,
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 4094),
+							},
+						},
+						"interface_description": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Interface description").String,
+							Optional:            true,
+						},
+						"orphan_port": schema.BoolAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("If enabled, configure the interface as a vPC orphan port to be suspended by the secondary peer in vPC failures").AddDefaultValueDescription("false").String,
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"freeform_config": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Additional CLI for the interface").String,
+							Optional:            true,
+						},
+						"admin_state": schema.BoolAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Enable or disable the interface").AddDefaultValueDescription("true").String,
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+						"ptp": schema.BoolAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Enable PTP").AddDefaultValueDescription("false").String,
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"netflow": schema.BoolAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Netflow is supported only if it is enabled on fabric").AddDefaultValueDescription("false").String,
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"netflow_monitor": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Provide the Layer 2 Monitor Name").String,
+							Optional:            true,
+						},
+						"netflow_sampler": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Netflow sampler name, applicable to N7K only").String,
+							Optional:            true,
+						},
+						"allowed_vlans": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Allowed vlans for the ethernet interface. Allowed values are `none`, `all` or VLAN ranges (1-200,500-2000,3000)").AddDefaultValueDescription("none").String,
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("none"),
+						},
+						"native_vlan": schema.Int64Attribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Set native VLAN for the interface").AddIntegerRangeDescription(1, 4094).String,
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 4094),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *InterfacesResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*NdfcProviderData).Client
+	r.updateMutex = req.ProviderData.(*NdfcProviderData).UpdateMutex
+}
+
+// apply sends the interface payloads and, if deploy is set, deploys the
+// interfaces at keys with a single request.
+func (r *InterfacesResource) apply(ctx context.Context, bodies []string, keys []string, deploy bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, body := range bodies {
+		_, err := r.client.Put(ctx, ndfc.InterfacePath, body)
+		if err != nil {
+			diags.Append(helpers.ClientError("Failed to configure objects (PUT)", err, nil))
+			return diags
+		}
+	}
+
+	if !deploy {
+		return diags
+	}
+
+	// Deploy interfaces
+	r.updateMutex.Lock()
+	defer r.updateMutex.Unlock()
+	diags.Append(helpers.DeployInterfaces(ctx, r.client, interfacesRefs(keys)...)...)
+	return diags
+}
+
+func (r *InterfacesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Interfaces
+
+	// Read plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	plan.Id = types.StringValue(strings.Join(plan.serialNumbers(), ","))
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
+
+	keys := interfacesKeys(plan.Interfaces)
+	resp.Diagnostics.Append(r.apply(ctx, plan.toBodies(ctx, keys), keys, plan.Deploy.ValueBool())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Create finished successfully", plan.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *InterfacesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Interfaces
+
+	// Read state
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
+
+	// Read the interfaces of each switch with a single request, interfaces
+	// NDFC does not list are looked up one by one
+	switches := map[string]gjson.Result{}
+	for _, serial := range state.serialNumbers() {
+		res, err := r.client.GetInterfaces(ctx, serial)
+		if err != nil && !ndfc.IsNotFound(err) {
+			resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve objects (GET)", err, nil))
+			return
+		}
+		switches[serial] = res
+	}
+	for _, key := range interfacesKeys(state.Interfaces) {
+		ref := interfacesRef(key)
+		res, ok := findInterface(switches[ref.SerialNumber], ref.IfName)
+		if !ok {
+			var err error
+			res, err = r.client.GetInterface(ctx, ref)
+			if err != nil {
+				if ndfc.IsNotFound(err) {
+					delete(state.Interfaces, key)
+					continue
+				} else {
+					resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve object (GET)", err, nil))
+					return
+				}
+			}
+		}
+		state.fromBody(ctx, key, res)
+	}
+	if len(state.Interfaces) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", state.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update only configures and deploys the interfaces that were added, changed
+// or removed. Removed interfaces are reset to destroy_policy. All interfaces
+// are deployed once deploy is enabled again.
+func (r *InterfacesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state Interfaces
+
+	// Read plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Read state
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
+
+	removed := plan.removed(state)
+	changed := plan.changed(ctx, state)
+	if plan.Deploy.ValueBool() && !state.Deploy.ValueBool() {
+		// Deploy the changes staged so far
+		changed = interfacesKeys(plan.Interfaces)
+	}
+	if len(removed) > 0 || len(changed) > 0 {
+		var defaults map[string]string
+		if len(removed) > 0 {
+			var err error
+			defaults, err = r.client.GetTemplateDefaults(ctx, plan.getDestroyPolicy())
+			if err != nil {
+				resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve destroy policy (GET)", err, nil))
+				return
+			}
+		}
+		bodies := append(plan.toDestroyBodies(ctx, removed, defaults), plan.toBodies(ctx, changed)...)
+		resp.Diagnostics.Append(r.apply(ctx, bodies, append(removed, changed...), plan.Deploy.ValueBool())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Update finished successfully", plan.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *InterfacesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Interfaces
+
+	// Read state
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
+
+	// Physical interfaces cannot be removed, reset them to the destroy policy
+	// and its default values
+	keys := interfacesKeys(state.Interfaces)
+	defaults, err := r.client.GetTemplateDefaults(ctx, state.getDestroyPolicy())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve destroy policy (GET)", err, nil))
+		return
+	}
+	resp.Diagnostics.Append(r.apply(ctx, state.toDestroyBodies(ctx, keys, defaults), keys, state.Deploy.ValueBool())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Delete finished successfully", state.Id.ValueString()))
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *InterfacesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	interfaces := map[string]InterfacesInterfaces{}
+	for _, key := range strings.Split(req.ID, ",") {
+		ref := interfacesRef(key)
+		if ref.SerialNumber == "" || ref.IfName == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected import identifier with format: '<serial_number>:<interface_name>[,<serial_number>:<interface_name>...]'. Got: %q", req.ID),
+			)
+			return
+		}
+		interfaces[key] = InterfacesInterfaces{}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("interfaces"), interfaces)...)
+}
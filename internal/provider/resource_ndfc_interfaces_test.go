@@ -0,0 +1,77 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNdfcInterfaces(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNdfcInterfacesConfig("int_access_host"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ndfc_interfaces.test", "interfaces.%", "3"),
+					resource.TestCheckResourceAttr("ndfc_interfaces.test", "interfaces.9DBYO6WQJ46:Ethernet1/20.access_vlan", "500"),
+					resource.TestCheckResourceAttr("ndfc_interfaces.test", "interfaces.9RB5Y9BFNTU:Ethernet1/20.allowed_vlans", "500-510"),
+				),
+			},
+			{
+				Config: testAccNdfcInterfacesConfig("int_trunk_host"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ndfc_interfaces.test", "interfaces.9DBYO6WQJ46:Ethernet1/20.policy", "int_trunk_host"),
+					resource.TestCheckNoResourceAttr("ndfc_interfaces.test", "interfaces.9DBYO6WQJ46:Ethernet1/20.access_vlan"),
+				),
+			},
+			{
+				ResourceName:  "ndfc_interfaces.test",
+				ImportState:   true,
+				ImportStateId: "9DBYO6WQJ46:Ethernet1/20,9DBYO6WQJ46:Ethernet1/21,9RB5Y9BFNTU:Ethernet1/20",
+			},
+		},
+	})
+}
+
+func testAccNdfcInterfacesConfig(policy string) string {
+	config := `
+resource "ndfc_interfaces" "test" {
+	interfaces = {
+		"9DBYO6WQJ46:Ethernet1/20" = {
+			policy = "` + policy + `"
+`
+	if policy == "int_access_host" {
+		config += `			access_vlan = 500
+`
+	}
+	config += `		}
+		"9DBYO6WQJ46:Ethernet1/21" = {
+			interface_description = "bulk"
+		}
+		"9RB5Y9BFNTU:Ethernet1/20" = {
+			allowed_vlans = "500-510"
+		}
+	}
+}
+`
+	return config
+}
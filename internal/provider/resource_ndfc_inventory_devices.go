@@ -0,0 +1,427 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/helpers"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &InventoryDevicesResource{}
+var _ resource.ResourceWithImportState = &InventoryDevicesResource{}
+
+func NewInventoryDevicesResource() resource.Resource {
+	return &InventoryDevicesResource{}
+}
+
+type InventoryDevicesResource struct {
+	client      *ndfc.Client
+	updateMutex *sync.Mutex
+}
+
+func (r *InventoryDevicesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inventory_devices"
+}
+
+func (r *InventoryDevicesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: helpers.NewAttributeDescription("This resource can discover switches into a fabric and assign their roles. Switches are removed from the fabric on destroy.").String,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The id of the object",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+			"fabric_name": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("The name of the fabric").String,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Username used to log into the switches during discovery").String,
+				Required:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Password used to log into the switches during discovery").String,
+				Required:            true,
+				Sensitive:           true,
+			},
+			"max_hops": schema.Int64Attribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Number of hops from the seed switches up to which neighbors are discovered as well").AddIntegerRangeDescription(0, 10).AddDefaultValueDescription("0").String,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 10),
+				},
+				Default: int64default.StaticInt64(0),
+			},
+			"preserve_config": schema.BoolAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Keep the existing configuration of the switches (brownfield)").AddDefaultValueDescription("false").String,
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"devices": schema.MapNestedAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Switches of the fabric, keyed by seed IP address").String,
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Role of the switch").AddStringEnumDescription("leaf", "spine", "border", "border gateway").AddDefaultValueDescription("leaf").String,
+							Optional:            true,
+							Computed:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("leaf", "spine", "border", "border gateway"),
+							},
+							Default: stringdefault.StaticString("leaf"),
+						},
+						"serial_number": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Serial number of the switch").String,
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"ip_address": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Management IP address of the switch").String,
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"hostname": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Hostname of the switch").String,
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *InventoryDevicesResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*NdfcProviderData).Client
+	r.updateMutex = req.ProviderData.(*NdfcProviderData).UpdateMutex
+}
+
+// discover adds the devices at ips to the fabric and waits until NDFC has
+// discovered them. It reports whether the devices were added, which is the
+// case even if the wait fails.
+func (r *InventoryDevicesResource) discover(ctx context.Context, plan *InventoryDevices, ips []string) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	fabric := plan.FabricName.ValueString()
+
+	err := r.client.DiscoverSwitches(ctx, fabric, plan.discoveryOptions(), ips...)
+	if err != nil {
+		diags.Append(helpers.ClientError("Failed to discover switches", err, nil))
+		return false, diags
+	}
+	switches, err := r.client.WaitForSwitches(ctx, fabric, false, ips...)
+	for ip, sw := range switches {
+		plan.fromSwitch(ip, sw)
+	}
+	if err != nil {
+		diags.Append(helpers.WaitError("Failed to wait for switch discovery", err))
+	}
+	return true, diags
+}
+
+// deploy assigns roles, which maps seed IPs to roles, and deploys the fabric
+// configuration. It waits until all devices of plan have left migration
+// mode.
+func (r *InventoryDevicesResource) deploy(ctx context.Context, plan *InventoryDevices, roles map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	fabric := plan.FabricName.ValueString()
+
+	if len(roles) > 0 {
+		serials := make(map[string]string, len(roles))
+		for ip, role := range roles {
+			serials[plan.Devices[ip].SerialNumber.ValueString()] = role
+		}
+		if err := r.client.SetSwitchRoles(ctx, serials); err != nil {
+			diags.Append(helpers.ClientError("Failed to set switch roles", err, nil))
+			return diags
+		}
+	}
+	if err := r.client.DeployFabric(ctx, fabric); err != nil {
+		diags.Append(helpers.ClientError("Failed to deploy fabric", err, nil))
+		return diags
+	}
+	switches, err := r.client.WaitForSwitches(ctx, fabric, true, seedIPs(plan.Devices)...)
+	for ip, sw := range switches {
+		plan.fromSwitch(ip, sw)
+	}
+	if err != nil {
+		diags.Append(helpers.WaitError("Failed to wait for switch migration", err))
+	}
+	return diags
+}
+
+func (r *InventoryDevicesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan InventoryDevices
+
+	// Read plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Create", plan.FabricName.ValueString()))
+
+	r.updateMutex.Lock()
+	defer r.updateMutex.Unlock()
+
+	plan.Id = plan.FabricName
+	ips := seedIPs(plan.Devices)
+	roles := make(map[string]string, len(ips))
+	for _, ip := range ips {
+		roles[ip] = plan.Devices[ip].Role.ValueString()
+	}
+	added, diags := r.discover(ctx, &plan, ips)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		if added {
+			// The switches are part of the fabric by now, keep them in the
+			// state so they are removed again.
+			plan.nullUnknown()
+			resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		}
+		return
+	}
+
+	resp.Diagnostics.Append(r.deploy(ctx, &plan, roles)...)
+	if resp.Diagnostics.HasError() {
+		// The switches are part of the fabric by now, keep them in the
+		// state so they are removed again.
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Create finished successfully", plan.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *InventoryDevicesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state InventoryDevices
+
+	// Read state
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
+
+	switches, err := r.client.GetSwitches(ctx, state.FabricName.ValueString())
+	if err != nil {
+		if ndfc.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve object (GET)", err, nil))
+			return
+		}
+	}
+
+	state.fromSwitches(switches)
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", state.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *InventoryDevicesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state InventoryDevices
+
+	// Read plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Read state
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
+
+	r.updateMutex.Lock()
+	defer r.updateMutex.Unlock()
+
+	var removed, added []string
+	roles := map[string]string{}
+	for _, ip := range seedIPs(state.Devices) {
+		if _, ok := plan.Devices[ip]; !ok {
+			removed = append(removed, ip)
+		}
+	}
+	for _, ip := range seedIPs(plan.Devices) {
+		device, ok := state.Devices[ip]
+		if !ok {
+			added = append(added, ip)
+			roles[ip] = plan.Devices[ip].Role.ValueString()
+		} else if !device.Role.Equal(plan.Devices[ip].Role) {
+			roles[ip] = plan.Devices[ip].Role.ValueString()
+		}
+	}
+
+	if len(removed) > 0 {
+		err := r.client.RemoveSwitches(ctx, plan.FabricName.ValueString(), state.serialNumbers(removed)...)
+		if err != nil {
+			resp.Diagnostics.Append(helpers.ClientError("Failed to remove switches", err, nil))
+			return
+		}
+	}
+	if len(added) > 0 {
+		discovered, diags := r.discover(ctx, &plan, added)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			if discovered {
+				// The switches are part of the fabric by now, keep them in
+				// the state so they are removed again.
+				plan.nullUnknown()
+				resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+			}
+			return
+		}
+	}
+	if len(removed) > 0 || len(roles) > 0 {
+		resp.Diagnostics.Append(r.deploy(ctx, &plan, roles)...)
+		if resp.Diagnostics.HasError() {
+			// Added switches are part of the fabric by now, keep them in
+			// the state so they are removed again.
+			resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+			return
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Update finished successfully", plan.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *InventoryDevicesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state InventoryDevices
+
+	// Read state
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
+
+	r.updateMutex.Lock()
+	defer r.updateMutex.Unlock()
+
+	err := r.client.RemoveSwitches(ctx, state.FabricName.ValueString(), state.serialNumbers(seedIPs(state.Devices))...)
+	if err != nil && !ndfc.IsNotFound(err) {
+		resp.Diagnostics.Append(helpers.ClientError("Failed to remove switches", err, nil))
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Delete finished successfully", state.Id.ValueString()))
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *InventoryDevicesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("fabric_name"), req.ID)...)
+}
@@ -0,0 +1,86 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNdfcInventoryDevices(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNdfcInventoryDevicesConfigMinimal,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ndfc_inventory_devices.test", "devices.10.0.0.103.role", "leaf"),
+					resource.TestCheckResourceAttr("ndfc_inventory_devices.test", "devices.10.0.0.103.serial_number", "9TQYTJSZ1VJ"),
+					resource.TestCheckResourceAttr("ndfc_inventory_devices.test", "devices.10.0.0.103.hostname", "LEAF3"),
+				),
+			},
+			{
+				Config: testAccNdfcInventoryDevicesConfigAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ndfc_inventory_devices.test", "devices.10.0.0.103.role", "border"),
+					resource.TestCheckResourceAttr("ndfc_inventory_devices.test", "devices.10.0.0.111.role", "border gateway"),
+					resource.TestCheckResourceAttr("ndfc_inventory_devices.test", "devices.10.0.0.111.serial_number", "9PN0KPO2XKN"),
+					resource.TestCheckResourceAttr("ndfc_inventory_devices.test", "devices.10.0.0.111.ip_address", "10.0.0.111"),
+				),
+			},
+			{
+				ResourceName:  "ndfc_inventory_devices.test",
+				ImportState:   true,
+				ImportStateId: "CML",
+			},
+		},
+	})
+}
+
+const testAccNdfcInventoryDevicesConfigMinimal = `
+
+resource "ndfc_inventory_devices" "test" {
+	fabric_name = "CML"
+	username = "admin"
+	password = "admin"
+	devices = {
+		"10.0.0.103" = {}
+	}
+}
+`
+
+const testAccNdfcInventoryDevicesConfigAll = `
+
+resource "ndfc_inventory_devices" "test" {
+	fabric_name = "CML"
+	username = "admin"
+	password = "admin"
+	max_hops = 0
+	preserve_config = false
+	devices = {
+		"10.0.0.103" = {
+			role = "border"
+		}
+		"10.0.0.111" = {
+			role = "border gateway"
+		}
+	}
+}
+`
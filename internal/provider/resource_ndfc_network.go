@@ -24,10 +24,11 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 	"sync"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -43,9 +44,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/netascode/go-nd"
 	"github.com/netascode/terraform-provider-ndfc/internal/provider/helpers"
-	"github.com/tidwall/sjson"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
 )
 
 //template:end imports
@@ -61,7 +61,7 @@ func NewNetworkResource() resource.Resource {
 }
 
 type NetworkResource struct {
-	client      *nd.Client
+	client      *ndfc.Client
 	updateMutex *sync.Mutex
 }
 
@@ -82,6 +82,12 @@ func (r *NetworkResource) Schema(ctx context.Context, req resource.SchemaRequest
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 			"fabric_name": schema.StringAttribute{
 				MarkdownDescription: helpers.NewAttributeDescription("The name of the fabric").String,
 				Optional:            true,
@@ -258,7 +264,7 @@ func (r *NetworkResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Default:             booldefault.StaticBool(false),
 			},
 			"attachments": schema.SetNestedAttribute{
-				MarkdownDescription: helpers.NewAttributeDescription("A list of attachments").String,
+				MarkdownDescription: helpers.NewAttributeDescription("A list of attachments. Switches attached outside of Terraform show up as a diff, except for those in `unmanaged_attachments`. If not set, the attachments of the network are not managed").String,
 				Optional:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -290,6 +296,16 @@ func (r *NetworkResource) Schema(ctx context.Context, req resource.SchemaRequest
 					},
 				},
 			},
+			"unmanaged_attachments": schema.ListAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Serial numbers of switches whose attachments are managed outside of this resource, e.g. with `ndfc_network_attachment`. They are left out of `attachments`. Example: `9DBYO6WQJ46`").String,
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"attachment_status": schema.MapAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Deployment state of the network on the switches it is attached to, keyed by serial number. A switch that is not `DEPLOYED` is deployed again on the next apply. Example: `OUT-OF-SYNC`").String,
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -315,50 +331,60 @@ func (r *NetworkResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
 
 	// Create object
 	body := plan.toBody(ctx)
-	r.updateMutex.Lock()
-	res, err := r.client.Post(plan.getPath(), body)
-	r.updateMutex.Unlock()
+	res, err := r.client.Post(ctx, plan.getPath(), body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to configure object (POST), got error: %s, %s", err, res.String()))
+		resp.Diagnostics.Append(helpers.ClientError("Failed to configure object (POST)", err, plan.fieldPaths()))
 		return
 	}
 	plan.Id = types.StringValue(plan.FabricName.ValueString() + "/" + plan.NetworkName.ValueString())
 
 	if len(plan.Attachments) > 0 {
 		// attach
-		res, err = r.client.Get(fmt.Sprintf("%vattachments?network-names=%v", plan.getPath(), plan.NetworkName.ValueString()))
+		res, err = r.client.Get(ctx, fmt.Sprintf("%vattachments?network-names=%v", plan.getPath(), plan.NetworkName.ValueString()))
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve network attachments, got error: %s, %s", err, res.String()))
+			resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve network attachments", err, nil))
 			return
 		}
-		bodyAttachments := plan.toBodyAttachments(ctx, res)
-		r.updateMutex.Lock()
-		res, err = r.client.Post(plan.getPath()+"attachments", bodyAttachments)
-		r.updateMutex.Unlock()
+		bodyAttachments := plan.toBodyAttachments(ctx, res, nil)
+		res, err = r.client.Post(ctx, plan.getPath()+"attachments", bodyAttachments)
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to configure network attachments, got error: %s, %s", err, res.String()))
+			resp.Diagnostics.Append(helpers.ClientError("Failed to configure network attachments", err, nil))
 			return
 		}
 
 		// deploy
-		diags = r.Deploy(ctx, plan, "DEPLOYED")
+		diags = r.Deploy(ctx, plan)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 	}
 
-	res, err = r.client.Get(fmt.Sprintf("%v%v", plan.getPath(), plan.NetworkName.ValueString()))
+	res, err = r.client.Get(ctx, fmt.Sprintf("%v%v", plan.getPath(), plan.NetworkName.ValueString()))
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, res.String()))
+		resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve object (GET)", err, nil))
 		return
 	}
 	plan.fromBody(ctx, res)
 
+	diags = r.readAttachmentStatus(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, fmt.Sprintf("%s: Create finished successfully", plan.Id.ValueString()))
 
 	diags = resp.State.Set(ctx, &plan)
@@ -375,26 +401,39 @@ func (r *NetworkResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
 
-	res, err := r.client.Get(fmt.Sprintf("%v%v", state.getPath(), state.NetworkName.ValueString()))
+	res, err := r.client.Get(ctx, fmt.Sprintf("%v%v", state.getPath(), state.NetworkName.ValueString()))
 	if err != nil {
-		if strings.Contains(err.Error(), "StatusCode 400") || strings.Contains(err.Error(), "StatusCode 500") {
+		if ndfc.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		} else {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, res.String()))
+			resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve object (GET)", err, nil))
 			return
 		}
 	}
 	state.fromBody(ctx, res)
 
-	res, err = r.client.Get(fmt.Sprintf("%vattachments?network-names=%v", state.getPath(), state.NetworkName.ValueString()))
+	res, err = r.client.Get(ctx, fmt.Sprintf("%vattachments?network-names=%v", state.getPath(), state.NetworkName.ValueString()))
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve network attachments, got error: %s, %s", err, res.String()))
+		resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve network attachments", err, nil))
 		return
 	}
-	state.fromBodyAttachments(ctx, res, false)
+	if state.Attachments != nil {
+		state.fromBodyManagedAttachments(ctx, res)
+	} else {
+		// attachments are not managed, e.g. by ndfc_network_attachment
+		state.AttachmentStatus = helpers.AttachmentStatus(res.Get("0"))
+	}
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", state.Id.ValueString()))
 
@@ -418,49 +457,76 @@ func (r *NetworkResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
 
 	plan.NetworkId = state.NetworkId
 	body := plan.toBody(ctx)
-	r.updateMutex.Lock()
-	res, err := r.client.Put(fmt.Sprintf("%v%v", plan.getPath(), plan.NetworkName.ValueString()), body)
-	r.updateMutex.Unlock()
+	res, err := r.client.Put(ctx, fmt.Sprintf("%v%v", plan.getPath(), plan.NetworkName.ValueString()), body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to configure object (PUT), got error: %s, %s", err, res.String()))
+		resp.Diagnostics.Append(helpers.ClientError("Failed to configure object (PUT)", err, plan.fieldPaths()))
 		return
 	}
 
-	if len(plan.Attachments) > 0 {
-		// attach
-		res, err = r.client.Get(fmt.Sprintf("%vattachments?network-names=%v", plan.getPath(), plan.NetworkName.ValueString()))
+	// only attach, detach and deploy the switches whose attachment changed,
+	// and redeploy the attached switches that are not DEPLOYED
+	changed := map[string]bool{}
+	if plan.Attachments != nil {
+		changed = plan.changedAttachments(state)
+	}
+	var serialNumbers []string
+	for serialNumber := range changed {
+		serialNumbers = append(serialNumbers, serialNumber)
+	}
+	status := state.AttachmentStatus.Elements()
+	for _, item := range plan.Attachments {
+		serialNumber := item.SerialNumber.ValueString()
+		if v, ok := status[serialNumber].(types.String); ok && !changed[serialNumber] && v.ValueString() != ndfc.StateDeployed {
+			serialNumbers = append(serialNumbers, serialNumber)
+		}
+	}
+	if len(changed) > 0 {
+		res, err = r.client.Get(ctx, fmt.Sprintf("%vattachments?network-names=%v", plan.getPath(), plan.NetworkName.ValueString()))
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve network attachments, got error: %s, %s", err, res.String()))
+			resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve network attachments", err, nil))
 			return
 		}
-		bodyAttachments := plan.toBodyAttachments(ctx, res)
-		r.updateMutex.Lock()
-		res, err = r.client.Post(plan.getPath()+"attachments", bodyAttachments)
-		r.updateMutex.Unlock()
+		bodyAttachments := plan.toBodyAttachments(ctx, res, changed)
+		res, err = r.client.Post(ctx, plan.getPath()+"attachments", bodyAttachments)
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to configure network attachments, got error: %s, %s", err, res.String()))
+			resp.Diagnostics.Append(helpers.ClientError("Failed to configure network attachments", err, nil))
 			return
 		}
-
-		// deploy
-		diags = r.Deploy(ctx, plan, "DEPLOYED")
+	}
+	if len(serialNumbers) > 0 {
+		sort.Strings(serialNumbers)
+		diags = r.Deploy(ctx, plan, serialNumbers...)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 	}
 
-	res, err = r.client.Get(fmt.Sprintf("%v%v", plan.getPath(), plan.NetworkName.ValueString()))
+	res, err = r.client.Get(ctx, fmt.Sprintf("%v%v", plan.getPath(), plan.NetworkName.ValueString()))
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve object (GET), got error: %s, %s", err, res.String()))
+		resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve object (GET)", err, nil))
 		return
 	}
 	plan.fromBody(ctx, res)
 
+	diags = r.readAttachmentStatus(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, fmt.Sprintf("%s: Update finished successfully", plan.Id.ValueString()))
 
 	diags = resp.State.Set(ctx, &plan)
@@ -477,34 +543,40 @@ func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
 
 	if len(state.Attachments) > 0 {
 		// detach everything
-		res, err := r.client.Get(fmt.Sprintf("%vattachments?network-names=%v", state.getPath(), state.NetworkName.ValueString()))
+		res, err := r.client.Get(ctx, fmt.Sprintf("%vattachments?network-names=%v", state.getPath(), state.NetworkName.ValueString()))
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to retrieve network attachments, got error: %s, %s", err, res.String()))
+			resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve network attachments", err, nil))
 			return
 		}
 		state.Attachments = make([]NetworkAttachments, 0)
-		bodyAttachments := state.toBodyAttachments(ctx, res)
-		r.updateMutex.Lock()
-		res, err = r.client.Post(state.getPath()+"attachments", bodyAttachments)
-		r.updateMutex.Unlock()
+		bodyAttachments := state.toBodyAttachments(ctx, res, nil)
+		res, err = r.client.Post(ctx, state.getPath()+"attachments", bodyAttachments)
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to configure network attachments, got error: %s, %s", err, res.String()))
+			resp.Diagnostics.Append(helpers.ClientError("Failed to configure network attachments", err, nil))
 			return
 		}
 
 		// deploy
-		diags = r.Deploy(ctx, state, "NA")
+		diags = r.Deploy(ctx, state)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 	} else {
 		// if there is an ongoing deploy, wait for it to finish
-		diags = r.WaitForStatus(ctx, state, "NA")
+		diags = r.WaitDeployments(ctx, state)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -512,9 +584,9 @@ func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 
 	// delete network
-	res, err := r.client.Delete(fmt.Sprintf("%v%v", state.getPath(), state.NetworkName.ValueString()), "")
+	_, err := r.client.Delete(ctx, fmt.Sprintf("%v%v", state.getPath(), state.NetworkName.ValueString()), "")
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Failed to delete object (DELETE), got error: %s, %s", err, res.String()))
+		resp.Diagnostics.Append(helpers.ClientError("Failed to delete object (DELETE)", err, nil))
 		return
 	}
 
@@ -523,23 +595,68 @@ func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest
 	resp.State.RemoveResource(ctx)
 }
 
-func (r *NetworkResource) Deploy(ctx context.Context, state Network, expectedStatus string) diag.Diagnostics {
-	var diags diag.Diagnostics
-	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Deploy", state.Id.ValueString()))
+var _ resource.ResourceWithModifyPlan = &NetworkResource{}
+
+// ModifyPlan plans the attachment_status expected after the apply: every
+// attached switch DEPLOYED. Switches that are not, e.g. because they were
+// changed outside of Terraform, show up as a diff and are deployed again.
+func (r *NetworkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var attachments types.Set
+	diags := req.Plan.GetAttribute(ctx, path.Root("attachments"), &attachments)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if attachments.IsNull() {
+		// attachments are not managed, e.g. by ndfc_network_attachment, so
+		// the status is only known after the apply if anything changes
+		return
+	}
+	status := types.MapUnknown(types.StringType)
+	if !attachments.IsUnknown() {
+		var items []NetworkAttachments
+		diags = attachments.ElementsAs(ctx, &items, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		serials := make([]types.String, len(items))
+		for i, item := range items {
+			serials[i] = item.SerialNumber
+		}
+		status = helpers.PlannedAttachmentStatus(serials, func(string) (string, bool) {
+			return ndfc.StateDeployed, true
+		})
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("attachment_status"), status)...)
+}
 
-	body := ""
-	body, _ = sjson.Set(body, "networkNames", state.NetworkName.ValueString())
-	r.updateMutex.Lock()
-	defer r.updateMutex.Unlock()
-	res, err := r.client.Post(state.getPath()+"deployments", body)
+// readAttachmentStatus sets the attachment_status of data from NDFC.
+func (r *NetworkResource) readAttachmentStatus(ctx context.Context, data *Network) diag.Diagnostics {
+	var diags diag.Diagnostics
+	res, err := r.client.Get(ctx, fmt.Sprintf("%vattachments?network-names=%v", data.getPath(), data.NetworkName.ValueString()))
 	if err != nil {
-		diags.AddError("Client Error", fmt.Sprintf("Failed to deploy network, got error: %s, %s", err, res.String()))
+		diags.Append(helpers.ClientError("Failed to retrieve network attachments", err, nil))
 		return diags
 	}
+	data.AttachmentStatus = helpers.FilterAttachmentStatus(helpers.AttachmentStatus(res.Get("0")), data.managedSerialNumbers())
+	return diags
+}
 
-	d := r.WaitForStatus(ctx, state, expectedStatus)
-	diags.Append(d...)
-	if diags.HasError() {
+// Deploy deploys the pending attachment changes of the network on the
+// switches with the given serial numbers, or on all switches if none are
+// given, and waits for them to complete. Deployments of concurrently applied
+// networks are batched by the client, so no lock is held here.
+func (r *NetworkResource) Deploy(ctx context.Context, state Network, serialNumbers ...string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Deploy", state.Id.ValueString()))
+
+	err := r.client.Deploy(ctx, ndfc.NetworkType, state.FabricName.ValueString(), state.NetworkName.ValueString(), serialNumbers...)
+	if err != nil {
+		diags.Append(helpers.WaitError("Failed to deploy network", err))
 		return diags
 	}
 
@@ -548,25 +665,12 @@ func (r *NetworkResource) Deploy(ctx context.Context, state Network, expectedSta
 	return diags
 }
 
-func (r *NetworkResource) WaitForStatus(ctx context.Context, state Network, expectedStatus string) diag.Diagnostics {
+func (r *NetworkResource) WaitDeployments(ctx context.Context, state Network) diag.Diagnostics {
 	var diags diag.Diagnostics
-	status := ""
-	for i := 0; i < (helpers.NDFC_CHECK_STATUS_RETRIES); i++ {
-		res, err := r.client.Get(state.getPath())
-		if err != nil {
-			diags.AddError("Client Error", fmt.Sprintf("Failed to retrieve networks, got error: %s, %s", err, res.String()))
-			return diags
-		}
-		status = res.Get(`#(networkName="` + state.NetworkName.ValueString() + `").networkStatus`).String()
 
-		if status == expectedStatus {
-			break
-		}
-		time.Sleep(5 * time.Second)
-	}
-	if status != expectedStatus {
-		diags.AddError("Client Error", fmt.Sprintf("Network deployment timed out, got status: %s", status))
-		return diags
+	err := r.client.WaitDeployments(ctx, ndfc.NetworkType, state.FabricName.ValueString(), state.NetworkName.ValueString())
+	if err != nil {
+		diags.Append(helpers.WaitError("Failed to wait for network deployment", err))
 	}
 	return diags
 }
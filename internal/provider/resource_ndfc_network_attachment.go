@@ -0,0 +1,416 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/helpers"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ resource.Resource                = &NetworkAttachmentResource{}
+	_ resource.ResourceWithImportState = &NetworkAttachmentResource{}
+	_ resource.ResourceWithModifyPlan  = &NetworkAttachmentResource{}
+)
+
+func NewNetworkAttachmentResource() resource.Resource {
+	return &NetworkAttachmentResource{}
+}
+
+type NetworkAttachmentResource struct {
+	client *ndfc.Client
+}
+
+func (r *NetworkAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_attachment"
+}
+
+func (r *NetworkAttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: helpers.NewAttributeDescription("This resource can manage the attachment of a network to a single switch. It can be used together with the `attachments` of the `ndfc_network` resource if the switch is listed in its `unmanaged_attachments`.").String,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The id of the object",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+			"fabric_name": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("The name of the fabric").String,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"network_name": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("The name of the network").String,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"serial_number": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Serial number of switch to attach").String,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vlan_id": schema.Int64Attribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Override VLAN ID. `-1` to use VLAN ID defined at network level").AddIntegerRangeDescription(-1, 4092).AddDefaultValueDescription("-1").String,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(-1, 4092),
+				},
+				Default: int64default.StaticInt64(-1),
+			},
+			"attach_switch_ports": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Comma separated list of attached switchports").String,
+				Optional:            true,
+			},
+			"detach_switch_ports": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Comma separated list of detached switchports").String,
+				Optional:            true,
+			},
+			"freeform_config": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("This field covers any configuration not included in overlay templates which is needed as part of this network attachment").String,
+				Optional:            true,
+			},
+			"extension_values": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Extension values of the attachment as JSON string, e.g. for VRF Lite on border switches").String,
+				Optional:            true,
+			},
+			"deploy": schema.BoolAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Deploy the attachment to the switch. If `false`, changes are only staged in NDFC and can be deployed later, e.g. with `ndfc_deploy`").AddDefaultValueDescription("true").String,
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Deployment state of the attachment. If `deploy` is enabled, an attachment that is not `DEPLOYED` is deployed again on the next apply. Example: `OUT-OF-SYNC`").String,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *NetworkAttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*NdfcProviderData).Client
+}
+
+// ModifyPlan plans the status expected after the apply: DEPLOYED if deploy is
+// enabled, unchanged if nothing else changes and unknown otherwise.
+func (r *NetworkAttachmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan, state NetworkAttachment
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	status := types.StringUnknown()
+	if plan.Deploy.ValueBool() {
+		status = types.StringValue(ndfc.StateDeployed)
+	} else if !req.State.Raw.IsNull() {
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.equal(state) {
+			status = state.Status
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), status)...)
+}
+
+// read updates data from NDFC. It returns false if the network or the switch no
+// longer exist or the network is not attached to the switch.
+func (r *NetworkAttachmentResource) read(ctx context.Context, data *NetworkAttachment) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	fabric, name := data.FabricName.ValueString(), data.NetworkName.ValueString()
+	res, err := r.client.Get(ctx, ndfc.NetworkType.Path(fabric)+name)
+	if err != nil {
+		if ndfc.IsNotFound(err) {
+			return false, diags
+		}
+		diags.Append(helpers.ClientError("Failed to retrieve object (GET)", err, nil))
+		return false, diags
+	}
+	vlan := res.Get("networkTemplateConfig.vlanId").Int()
+	res, err = r.client.GetAttachment(ctx, ndfc.NetworkType, fabric, name, data.SerialNumber.ValueString())
+	if err != nil {
+		if ndfc.IsNotFound(err) {
+			return false, diags
+		}
+		diags.Append(helpers.ClientError("Failed to retrieve network attachments", err, nil))
+		return false, diags
+	}
+	if !res.Get("isLanAttached").Bool() {
+		return false, diags
+	}
+	data.fromBody(ctx, res, vlan)
+	return true, diags
+}
+
+// attach posts the attachment of data, or its detachment if attach is false,
+// and deploys it if deploy is enabled. It returns true if the attachment was
+// posted, even if the deployment failed.
+func (r *NetworkAttachmentResource) attach(ctx context.Context, data NetworkAttachment, attach bool) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	fabric, name := data.FabricName.ValueString(), data.NetworkName.ValueString()
+	res, err := r.client.Post(ctx, ndfc.NetworkType.Path(fabric)+"attachments", data.toBody(ctx, attach))
+	if err != nil {
+		diags.Append(helpers.ClientError("Failed to configure network attachments", err, data.fieldPaths()))
+		return false, diags
+	}
+	diags = helpers.CheckAttachmentResponse(ctx, res)
+	if diags.HasError() || !data.Deploy.ValueBool() {
+		return !diags.HasError(), diags
+	}
+	return true, helpers.DeployAttachment(ctx, r.client, ndfc.NetworkType, fabric, name, data.SerialNumber.ValueString())
+}
+
+func (r *NetworkAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan NetworkAttachment
+
+	// Read plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	plan.Id = types.StringValue(plan.getId())
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
+
+	res, err := r.client.GetAttachment(ctx, ndfc.NetworkType, plan.FabricName.ValueString(), plan.NetworkName.ValueString(), plan.SerialNumber.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve network attachments", err, nil))
+		return
+	}
+	if res.Get("isLanAttached").Bool() {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Network %s is already attached to switch %s, import the attachment to manage it", plan.NetworkName.ValueString(), plan.SerialNumber.ValueString()))
+		return
+	}
+
+	attached, diags := r.attach(ctx, plan, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		if attached {
+			// The switch is attached by now, keep the attachment in the
+			// state with its observed status so it is deployed or
+			// detached again.
+			_, diags = r.read(ctx, &plan)
+			resp.Diagnostics.Append(diags...)
+			if plan.Status.IsUnknown() {
+				plan.Status = types.StringNull()
+			}
+			resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		}
+		return
+	}
+
+	found, diags := r.read(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Network %s is not attached to switch %s after attaching it", plan.NetworkName.ValueString(), plan.SerialNumber.ValueString()))
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Create finished successfully", plan.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *NetworkAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state NetworkAttachment
+
+	// Read state
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
+
+	found, diags := r.read(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", state.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update changes the attachment in place, the switch stays attached.
+func (r *NetworkAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan NetworkAttachment
+
+	// Read plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
+
+	_, diags = r.attach(ctx, plan, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags = r.read(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Update finished successfully", plan.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *NetworkAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state NetworkAttachment
+
+	// Read state
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
+
+	_, diags = r.attach(ctx, state, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Delete finished successfully", state.Id.ValueString()))
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *NetworkAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ":")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: '<fabric_name>:<network_name>:<serial_number>'. Got: %q", req.ID),
+		)
+		return
+	}
+
+	data := NetworkAttachment{
+		FabricName:   types.StringValue(idParts[0]),
+		NetworkName:  types.StringValue(idParts[1]),
+		SerialNumber: types.StringValue(idParts[2]),
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), data.getId())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("fabric_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial_number"), idParts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vlan_id"), -1)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deploy"), true)...)
+}
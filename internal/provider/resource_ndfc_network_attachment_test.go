@@ -0,0 +1,154 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNdfcNetworkAttachment(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNdfcNetworkAttachmentConfigMinimal,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ndfc_network_attachment.test", "vlan_id", "-1"),
+					resource.TestCheckResourceAttr("ndfc_network_attachment.test", "status", "DEPLOYED"),
+				),
+			},
+			{
+				Config: testAccNdfcNetworkAttachmentConfigAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ndfc_network_attachment.test", "fabric_name", "CML"),
+					resource.TestCheckResourceAttr("ndfc_network_attachment.test", "network_name", "NET1"),
+					resource.TestCheckResourceAttr("ndfc_network_attachment.test", "serial_number", "9DBYO6WQJ46"),
+					resource.TestCheckResourceAttr("ndfc_network_attachment.test", "vlan_id", "2010"),
+					resource.TestCheckResourceAttr("ndfc_network_attachment.test", "attach_switch_ports", "Ethernet1/10,Ethernet1/11"),
+					resource.TestCheckResourceAttr("ndfc_network_attachment.test", "deploy", "false"),
+					resource.TestCheckResourceAttr("ndfc_network_attachment.test", "status", "PENDING"),
+				),
+			},
+			{
+				ResourceName:            "ndfc_network_attachment.test",
+				ImportState:             true,
+				ImportStateId:           "CML:NET1:9DBYO6WQJ46",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts", "deploy"},
+			},
+		},
+	})
+}
+
+const testAccNdfcNetworkAttachmentConfigMinimal = `
+resource "ndfc_vrf" "test" {
+	fabric_name = "CML"
+	vrf_name = "VRF1"
+}
+
+resource "ndfc_network" "test" {
+	fabric_name = "CML"
+	network_name = "NET1"
+	vrf_name = ndfc_vrf.test.vrf_name
+}
+
+resource "ndfc_network_attachment" "test" {
+	fabric_name = "CML"
+	network_name = ndfc_network.test.network_name
+	serial_number = "9DBYO6WQJ46"
+}
+`
+
+const testAccNdfcNetworkAttachmentConfigAll = `
+resource "ndfc_vrf" "test" {
+	fabric_name = "CML"
+	vrf_name = "VRF1"
+}
+
+resource "ndfc_network" "test" {
+	fabric_name = "CML"
+	network_name = "NET1"
+	vrf_name = ndfc_vrf.test.vrf_name
+}
+
+resource "ndfc_network_attachment" "test" {
+	fabric_name = "CML"
+	network_name = ndfc_network.test.network_name
+	serial_number = "9DBYO6WQJ46"
+	vlan_id = 2010
+	attach_switch_ports = "Ethernet1/10,Ethernet1/11"
+	deploy = false
+}
+`
+
+func TestAccNdfcNetworkAttachmentWithNetworkAttachments(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNdfcNetworkAttachmentConfigWithNetworkAttachments(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ndfc_network.test", "attachments.#", "1"),
+					resource.TestCheckResourceAttr("ndfc_network.test", "attachment_status.%", "1"),
+					resource.TestCheckResourceAttr("ndfc_network.test", "attachment_status.9RB5Y9BFNTU", "DEPLOYED"),
+					resource.TestCheckResourceAttr("ndfc_network_attachment.test", "status", "DEPLOYED"),
+				),
+			},
+			{
+				Config: testAccNdfcNetworkAttachmentConfigWithNetworkAttachments(`attach_switch_ports = "Ethernet1/12"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ndfc_network.test", "attachments.#", "1"),
+					resource.TestCheckResourceAttr("ndfc_network.test", "attachments.0.attach_switch_ports", "Ethernet1/12"),
+					resource.TestCheckResourceAttr("ndfc_network.test", "attachment_status.%", "1"),
+					resource.TestCheckResourceAttr("ndfc_network_attachment.test", "status", "DEPLOYED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNdfcNetworkAttachmentConfigWithNetworkAttachments(attributes string) string {
+	return `
+resource "ndfc_vrf" "test" {
+	fabric_name = "CML"
+	vrf_name = "VRF1"
+}
+
+resource "ndfc_network" "test" {
+	fabric_name = "CML"
+	network_name = "NET1"
+	vrf_name = ndfc_vrf.test.vrf_name
+	attachments = [{
+		serial_number = "9RB5Y9BFNTU"
+		` + attributes + `
+	}]
+	unmanaged_attachments = ["9DBYO6WQJ46"]
+}
+
+resource "ndfc_network_attachment" "test" {
+	fabric_name = "CML"
+	network_name = ndfc_network.test.network_name
+	serial_number = "9DBYO6WQJ46"
+	attach_switch_ports = "Ethernet1/10"
+}
+`
+}
@@ -148,3 +148,140 @@ resource "ndfc_network" "test" {
 `
 
 //template:end testAccConfigAll
+
+func TestAccNdfcNetworkAttachmentStatus(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNdfcNetworkConfigAttachmentStatus,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ndfc_network.test", "attachment_status.%", "1"),
+					resource.TestCheckResourceAttr("ndfc_network.test", "attachment_status.9DBYO6WQJ46", "DEPLOYED"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNdfcNetworkConfigAttachmentStatus = `
+resource "ndfc_vrf" "test" {
+  fabric_name = "CML"
+  vrf_name = "VRF1"
+}
+resource "ndfc_network" "test" {
+	fabric_name = "CML"
+	network_name = "NET1"
+	vrf_name = ndfc_vrf.test.vrf_name
+	attachments = [{
+		serial_number = "9DBYO6WQJ46"
+	}]
+}
+`
+
+func TestAccNdfcNetworkAttachmentUpdate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNdfcNetworkConfigAttachments(`{
+		serial_number = "9DBYO6WQJ46"
+		attach_switch_ports = "Ethernet1/10"
+	}, {
+		serial_number = "9RB5Y9BFNTU"
+	}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ndfc_network.test", "attachments.#", "2"),
+					resource.TestCheckResourceAttr("ndfc_network.test", "attachment_status.9RB5Y9BFNTU", "DEPLOYED"),
+				),
+			},
+			{
+				Config: testAccNdfcNetworkConfigAttachments(`{
+		serial_number = "9DBYO6WQJ46"
+		attach_switch_ports = "Ethernet1/10,Ethernet1/11"
+	}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ndfc_network.test", "attachments.#", "1"),
+					resource.TestCheckResourceAttr("ndfc_network.test", "attachments.0.attach_switch_ports", "Ethernet1/10,Ethernet1/11"),
+					resource.TestCheckResourceAttr("ndfc_network.test", "attachment_status.%", "1"),
+					resource.TestCheckResourceAttr("ndfc_network.test", "attachment_status.9DBYO6WQJ46", "DEPLOYED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNdfcNetworkAttachmentDrift(t *testing.T) {
+	path := "/lan-fabric/rest/top-down/v2/fabrics/CML/networks/attachments"
+	config := testAccNdfcNetworkConfigAttachments(`{
+		serial_number = "9DBYO6WQJ46"
+	}`)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("ndfc_network.test", "attachment_status.9DBYO6WQJ46", "DEPLOYED"),
+			},
+			{
+				PreConfig: func() {
+					testAccPost(t, path, `[{"networkName":"NET1","lanAttachList":[{"fabric":"CML","networkName":"NET1","serialNumber":"9RB5Y9BFNTU","vlan":-1,"deployment":true}]}]`)
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ndfc_network.test", "attachments.#", "1"),
+					resource.TestCheckResourceAttr("ndfc_network.test", "attachment_status.%", "1"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccPost(t, path, `[{"networkName":"NET1","lanAttachList":[{"fabric":"CML","networkName":"NET1","serialNumber":"9DBYO6WQJ46","deployment":false}]}]`)
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("ndfc_network.test", "attachment_status.9DBYO6WQJ46", "DEPLOYED"),
+			},
+			{
+				PreConfig: func() {
+					testAccSetAttachmentState(t, "networks", "NET1", "9DBYO6WQJ46", "OUT-OF-SYNC")
+				},
+				SkipFunc:           testAccSkipWithoutMock,
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				SkipFunc: testAccSkipWithoutMock,
+				Config:   config,
+				Check:    resource.TestCheckResourceAttr("ndfc_network.test", "attachment_status.9DBYO6WQJ46", "DEPLOYED"),
+			},
+		},
+	})
+}
+
+func testAccNdfcNetworkConfigAttachments(attachments string) string {
+	return `
+resource "ndfc_vrf" "test" {
+  fabric_name = "CML"
+  vrf_name = "VRF1"
+}
+resource "ndfc_network" "test" {
+	fabric_name = "CML"
+	network_name = "NET1"
+	vrf_name = ndfc_vrf.test.vrf_name
+	attachments = [` + attachments + `]
+}
+`
+}
@@ -0,0 +1,326 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/helpers"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &VpcPairResource{}
+var _ resource.ResourceWithImportState = &VpcPairResource{}
+
+func NewVpcPairResource() resource.Resource {
+	return &VpcPairResource{}
+}
+
+type VpcPairResource struct {
+	client      *ndfc.Client
+	updateMutex *sync.Mutex
+}
+
+func (r *VpcPairResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_pair"
+}
+
+func (r *VpcPairResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: helpers.NewAttributeDescription("This resource can manage a vPC pair of two switches.").String,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The id of the object",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+			"peer1_serial_number": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Serial number of the first switch of the pair").String,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"peer2_serial_number": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Serial number of the second switch of the pair").String,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"virtual_peer_link": schema.BoolAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Use a virtual peer link over the fabric instead of a physical peer link").AddDefaultValueDescription("false").String,
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"consistency_status": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Consistency status of the vPC pair, e.g. `consistent`").String,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *VpcPairResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*NdfcProviderData).Client
+	r.updateMutex = req.ProviderData.(*NdfcProviderData).UpdateMutex
+}
+
+// readConsistency sets the consistency status of the pair.
+func (r *VpcPairResource) readConsistency(ctx context.Context, data *VpcPair) diag.Diagnostics {
+	var diags diag.Diagnostics
+	status, err := r.client.GetVpcPairConsistency(ctx, data.Peer1SerialNumber.ValueString())
+	if err != nil {
+		diags.Append(helpers.ClientError("Failed to retrieve vPC pair consistency status", err, nil))
+		return diags
+	}
+	data.ConsistencyStatus = types.StringValue(status)
+	return diags
+}
+
+func (r *VpcPairResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan VpcPair
+
+	// Read plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	plan.Id = types.StringValue(plan.Peer1SerialNumber.ValueString() + "/" + plan.Peer2SerialNumber.ValueString())
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
+
+	// Create object
+	body := plan.toBody(ctx)
+
+	_, err := r.client.Post(ctx, plan.getPath(), body)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ClientError("Failed to configure object (POST)", err, plan.fieldPaths()))
+		return
+	}
+
+	// Deploy switches
+	r.updateMutex.Lock()
+	defer r.updateMutex.Unlock()
+	diags = helpers.DeploySwitches(ctx, r.client, plan.Peer1SerialNumber.ValueString(), plan.Peer2SerialNumber.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(r.readConsistency(ctx, &plan)...)
+	}
+	if resp.Diagnostics.HasError() {
+		// The pair exists by now, keep it in the state so it is removed
+		// again.
+		if plan.ConsistencyStatus.IsUnknown() {
+			plan.ConsistencyStatus = types.StringNull()
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Create finished successfully", plan.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *VpcPairResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state VpcPair
+
+	// Read state
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
+
+	res, err := r.client.Get(ctx, fmt.Sprintf("%v?serialNumber=%v", state.getPath(), url.QueryEscape(state.Peer1SerialNumber.ValueString())))
+	if err != nil {
+		if ndfc.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve object (GET)", err, nil))
+			return
+		}
+	}
+
+	state.fromBody(ctx, res)
+
+	resp.Diagnostics.Append(r.readConsistency(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", state.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *VpcPairResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan VpcPair
+
+	// Read plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
+
+	body := plan.toBody(ctx)
+	_, err := r.client.Put(ctx, plan.getPath(), body)
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ClientError("Failed to configure object (PUT)", err, plan.fieldPaths()))
+		return
+	}
+
+	// Deploy switches
+	r.updateMutex.Lock()
+	defer r.updateMutex.Unlock()
+	diags = helpers.DeploySwitches(ctx, r.client, plan.Peer1SerialNumber.ValueString(), plan.Peer2SerialNumber.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.readConsistency(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Update finished successfully", plan.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *VpcPairResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state VpcPair
+
+	// Read state
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
+
+	_, err := r.client.Delete(ctx, fmt.Sprintf("%v?serialNumber=%v", state.getPath(), url.QueryEscape(state.Peer1SerialNumber.ValueString())), "")
+	if err != nil && !ndfc.IsNotFound(err) {
+		resp.Diagnostics.Append(helpers.ClientError("Failed to delete object (DELETE)", err, nil))
+		return
+	}
+
+	// Deploy switches to remove the vPC configuration
+	r.updateMutex.Lock()
+	defer r.updateMutex.Unlock()
+	diags = helpers.DeploySwitches(ctx, r.client, state.Peer1SerialNumber.ValueString(), state.Peer2SerialNumber.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Delete finished successfully", state.Id.ValueString()))
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *VpcPairResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	idParts := strings.Split(req.ID, ":")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: '<peer1_serial_number>:<peer2_serial_number>'. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("peer1_serial_number"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("peer2_serial_number"), idParts[1])...)
+}
@@ -0,0 +1,71 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNdfcVpcPair(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNdfcVpcPairConfigMinimal,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ndfc_vpc_pair.test", "virtual_peer_link", "false"),
+					resource.TestCheckResourceAttr("ndfc_vpc_pair.test", "consistency_status", "consistent"),
+				),
+			},
+			{
+				Config: testAccNdfcVpcPairConfigAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ndfc_vpc_pair.test", "peer1_serial_number", "9DBYO6WQJ46"),
+					resource.TestCheckResourceAttr("ndfc_vpc_pair.test", "peer2_serial_number", "9RB5Y9BFNTU"),
+					resource.TestCheckResourceAttr("ndfc_vpc_pair.test", "virtual_peer_link", "true"),
+					resource.TestCheckResourceAttr("ndfc_vpc_pair.test", "consistency_status", "consistent"),
+				),
+			},
+			{
+				ResourceName:  "ndfc_vpc_pair.test",
+				ImportState:   true,
+				ImportStateId: "9DBYO6WQJ46:9RB5Y9BFNTU",
+			},
+		},
+	})
+}
+
+const testAccNdfcVpcPairConfigMinimal = `
+
+resource "ndfc_vpc_pair" "test" {
+	peer1_serial_number = "9DBYO6WQJ46"
+	peer2_serial_number = "9RB5Y9BFNTU"
+}
+`
+
+const testAccNdfcVpcPairConfigAll = `
+
+resource "ndfc_vpc_pair" "test" {
+	peer1_serial_number = "9DBYO6WQJ46"
+	peer2_serial_number = "9RB5Y9BFNTU"
+	virtual_peer_link = true
+}
+`
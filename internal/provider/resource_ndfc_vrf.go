@@ -27,8 +27,10 @@ import (
 	"strings"
 	"reflect"
 	"log"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -40,9 +42,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/netascode/terraform-provider-ndfc/internal/provider/helpers"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
 )
 
 
@@ -51,16 +55,25 @@ import (
 //template:begin model
 
 // Ensure provider defined types fully satisfy framework interfaces
-var _ resource.Resource = &NdfcClient{}
-var _ resource.ResourceWithImportState = &NdfcClient{}
+var _ resource.Resource = &VRFResource{}
+var _ resource.ResourceWithImportState = &VRFResource{}
+
+func NewVRFResource() resource.Resource {
+	return &VRFResource{}
+}
+
+type VRFResource struct {
+	client      *ndfc.Client
+	updateMutex *sync.Mutex
+}
 
 
-func (r *NdfcClient) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+func (r *VRFResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_vrf"
 }
 
 
-func (r *NdfcClient) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *VRFResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: helpers.NewAttributeDescription("This resource can manage a VRF.").String,
@@ -320,7 +333,7 @@ func (r *NdfcClient) Schema(ctx context.Context, req resource.SchemaRequest, res
 				Optional:            true,
 			},
 			"attachments": schema.SetNestedAttribute{
-				MarkdownDescription: helpers.NewAttributeDescription("A list of attachments").String,
+				MarkdownDescription: helpers.NewAttributeDescription("A list of attachments. Switches not in the list, e.g. attached with `ndfc_vrf_attachment`, are left alone. If not set, the attachments of the VRF are not managed").String,
 				Optional:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
@@ -370,14 +383,76 @@ func (r *NdfcClient) Schema(ctx context.Context, req resource.SchemaRequest, res
 							//Computed:            true,
 							//Default:  stringdefault.StaticString(""),
 						},
+						"vrf_lite": schema.ListNestedAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("VRF Lite connections of the attachment on a border switch, serialized into the extension values of the attachment").String,
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"interface_name": schema.StringAttribute{
+										MarkdownDescription: helpers.NewAttributeDescription("Interface of the VRF Lite connection").String,
+										Required:            true,
+									},
+									"dot1q_id": schema.Int64Attribute{
+										MarkdownDescription: helpers.NewAttributeDescription("DOT1Q ID of the VRF Lite subinterface").AddIntegerRangeDescription(2, 4093).String,
+										Required:            true,
+										Validators: []validator.Int64{
+											int64validator.Between(2, 4093),
+										},
+									},
+									"ipv4_address": schema.StringAttribute{
+										MarkdownDescription: helpers.NewAttributeDescription("IPv4 address of the VRF Lite subinterface with mask").String,
+										Optional:            true,
+									},
+									"neighbor_ipv4": schema.StringAttribute{
+										MarkdownDescription: helpers.NewAttributeDescription("IPv4 address of the neighbor").String,
+										Optional:            true,
+									},
+									"neighbor_asn": schema.StringAttribute{
+										MarkdownDescription: helpers.NewAttributeDescription("BGP ASN of the neighbor").String,
+										Optional:            true,
+									},
+									"ipv6_address": schema.StringAttribute{
+										MarkdownDescription: helpers.NewAttributeDescription("IPv6 address of the VRF Lite subinterface with mask").String,
+										Optional:            true,
+									},
+									"neighbor_ipv6": schema.StringAttribute{
+										MarkdownDescription: helpers.NewAttributeDescription("IPv6 address of the neighbor").String,
+										Optional:            true,
+									},
+									"peer_vrf_name": schema.StringAttribute{
+										MarkdownDescription: helpers.NewAttributeDescription("Name of the VRF on the neighbor").String,
+										Optional:            true,
+									},
+									"auto_vrf_lite": schema.BoolAttribute{
+										MarkdownDescription: helpers.NewAttributeDescription("Connection was created by the auto VRF Lite of the fabric").AddDefaultValueDescription("false").String,
+										Optional:            true,
+										Computed:            true,
+										Default:             booldefault.StaticBool(false),
+									},
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
 					},
 				},
 			},
+			"unmanaged_attachments": schema.ListAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Serial numbers of switches whose attachments are managed outside of this resource, e.g. with `ndfc_vrf_attachment`. They are left out of `attachments`. Example: `9DBYO6WQJ46`").String,
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"attachment_status": schema.MapAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Deployment state of the VRF on the switches it is attached to, keyed by serial number. A switch with `deploy_config` enabled that is not `DEPLOYED` is deployed again on the next apply. Example: `OUT-OF-SYNC`").String,
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
 		},
 	}
 }
 
-func (r *NdfcClient) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+func (r *VRFResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
@@ -388,7 +463,7 @@ func (r *NdfcClient) Configure(_ context.Context, req resource.ConfigureRequest,
 
 //template:end model
 
-func (r *NdfcClient) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+func (r *VRFResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var state VRF
 	// Read plan
 	logit()
@@ -396,11 +471,11 @@ func (r *NdfcClient) Create(ctx context.Context, req resource.CreateRequest, res
 	if ndfcCheckDiags(diags, resp) {
 		return
 	}
-    tflog.Debug(ctx, "Setting timeout for CREATE operation")
-    ctx, diags = state.ndfcSetTimeOut(ctx, "CREATE")
+	ctx, cancel, diags := state.ndfcSetTimeOut(ctx, "CREATE")
 	if ndfcCheckDiags(diags, resp) {
-		tflog.Debug(ctx,"Timeout is set for CREATE operation")
+		return
 	}
+	defer cancel()
     if r.ndfcVrfCreate(ctx, req, resp, &state) == failed {
         return
 	}
@@ -409,7 +484,7 @@ func (r *NdfcClient) Create(ctx context.Context, req resource.CreateRequest, res
 	ndfcCheckDiags(diags, resp)
 }
 
-func (r *NdfcClient) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+func (r *VRFResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state VRF
     logit()
 	// Read state
@@ -426,10 +501,11 @@ func (r *NdfcClient) Read(ctx context.Context, req resource.ReadRequest, resp *r
 
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
 
-	ctx, diags = state.ndfcSetTimeOut(ctx, "READ")
+	ctx, cancel, diags := state.ndfcSetTimeOut(ctx, "READ")
 	if ndfcCheckDiags(diags, resp) {
-		tflog.Debug(ctx,"Timeout is set for READ operation")
+		return
 	}
+	defer cancel()
 	if r.ndfcVrfRead(ctx, req, resp, &state) == failed {
 		return
 	}
@@ -438,78 +514,72 @@ func (r *NdfcClient) Read(ctx context.Context, req resource.ReadRequest, resp *r
 	ndfcCheckDiags(diags, resp)
 }
 
-func (r *NdfcClient) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+func (r *VRFResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan, state, temp VRF
-	var delete_attachments bool = false
 	logit()
 	// Read the plan after computing the change
 	diags := req.Plan.Get(ctx, &plan)
 	if ndfcCheckDiags(diags, resp) {
-		tflog.Debug(ctx,"Timeout is set for UPDATE operation")
+		return
 	}
 	// Read config state from terrafrom .tf file
 	diags = req.State.Get(ctx, &state)
 	if ndfcCheckDiags(diags, resp) {
-		tflog.Debug(ctx,"Timeout is set for UPDATE operation")
+		return
 	}
     log.Printf("Akash Plan config : %v", plan)
 	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
-	ctx, diags = state.ndfcSetTimeOut(ctx, "UPDATE")
+	ctx, cancel, diags := plan.ndfcSetTimeOut(ctx, "UPDATE")
 	if ndfcCheckDiags(diags, resp) {
-		tflog.Debug(ctx,"Timeout is set for UPDATE operation")
+		return
 	}
+	defer cancel()
 
-	is_equal := reflect.DeepEqual(plan, state)
-	log.Printf("Akash is_equal plan and state : %v", is_equal)
-	if !is_equal {
-		temp = plan
-		if len(plan.Attachments) > 0 {
-			delete_attachments = false
-			if len (state.Attachments) > 0 {
-				is_equal := reflect.DeepEqual(plan.Attachments, state.Attachments)
-				log.Printf("Akash is_equal plan.Attachments and state.Attachments : %v", is_equal)
-				if is_equal {
-					temp.Attachments = nil
-				} else {
-					tempNewAttachments, tempDelAttachments := r.ndfcCompareVrfAttachments(plan, state)
-					log.Printf("Akash tempNewAttachments %v : tempDelAttachments %v ", tempNewAttachments, tempDelAttachments)
-					if len(tempDelAttachments) > 0 {
-						temp.Attachments = tempDelAttachments
-						delete_attachments = true
-						if r.ndfcVrfUpdate(ctx, req, resp, &temp, delete_attachments) == failed {
-							return
-						}
-					}
-					if len(tempNewAttachments) > 0 {
-						temp.Attachments = tempNewAttachments
-						delete_attachments = false
-						if r.ndfcVrfUpdate(ctx, req, resp, &temp, delete_attachments) == failed {
-							return
-						}
-					}
-				}
+	if !reflect.DeepEqual(plan, state) {
+		// attachments are compared by serial number: switches removed from
+		// the attachments are detached, new and changed ones are updated in
+		// place without detaching them first
+		tempNewAttachments, tempDelAttachments := r.ndfcCompareVrfAttachments(plan, state)
+		tflog.Debug(ctx, fmt.Sprintf("%s: %d attachments to update, %d to detach", plan.Id.ValueString(), len(tempNewAttachments), len(tempDelAttachments)))
+		if len(tempDelAttachments) > 0 {
+			temp = plan
+			temp.Attachments = tempDelAttachments
+			if r.ndfcVrfUpdate(ctx, req, resp, &temp, true) == failed {
+				return
 			}
-		} else {
-			if len (state.Attachments) > 0 {
-				log.Printf("state.Attachments > 0 true")
-				temp.Attachments = state.Attachments
-				delete_attachments = true
-			} else{
-				delete_attachments = false
+		}
+		temp = plan
+		temp.Attachments = tempNewAttachments
+		if r.ndfcVrfUpdate(ctx, req, resp, &temp, false) == failed {
+			return
+		}
+		// switches deployed above need no redeploy
+		temp = plan
+		temp.Attachments = nil
+		updated := make(map[string]bool, len(tempNewAttachments))
+		for _, item := range tempNewAttachments {
+			updated[item.SerialNumber.ValueString()] = true
+		}
+		for _, item := range plan.Attachments {
+			if !updated[item.SerialNumber.ValueString()] {
+				temp.Attachments = append(temp.Attachments, item)
 			}
 		}
-		log.Printf("Akash len delete_attachments : %v", delete_attachments)
-		if r.ndfcVrfUpdate(ctx, req, resp, &temp, delete_attachments) == failed {
+		if r.ndfcVrfRedeploy(ctx, resp, &temp, &state) == failed {
 			return
 		}
 	}
+	diags = r.ndfcVrfReadAttachmentStatus(ctx, &plan)
+	if ndfcCheckDiags(diags, resp) {
+		return
+	}
 	state = plan
 	tflog.Debug(ctx, fmt.Sprintf("%s: Update finished successfully", plan.Id.ValueString()))
 	diags = resp.State.Set(ctx, &state)
 	ndfcCheckDiags(diags, resp)
 }
 
-func (r *NdfcClient) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+func (r *VRFResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state VRF
 	// Read state
 	logit()
@@ -518,18 +588,80 @@ func (r *NdfcClient) Delete(ctx context.Context, req resource.DeleteRequest, res
 	if ndfcCheckDiags(diags, resp) {
 		return
 	}
-	ctx, diags = state.ndfcSetTimeOut(ctx, "DELETE")
+	ctx, cancel, diags := state.ndfcSetTimeOut(ctx, "DELETE")
 	if ndfcCheckDiags(diags, resp) {
-		tflog.Debug(ctx,"Timeout is set for DELETE operation")
+		return
 	}
+	defer cancel()
 	if r.ndfcVrfDelete(ctx, req, resp, &state) == failed {
 		return
 	}
 	resp.State.RemoveResource(ctx)
 }
 
+var _ resource.ResourceWithModifyPlan = &VRFResource{}
+
+// ModifyPlan plans the attachment_status expected after the apply: DEPLOYED
+// for switches with deploy_config enabled, unchanged for other switches whose
+// attachment does not change and unknown otherwise.
+func (r *VRFResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var attachments types.Set
+	diags := req.Plan.GetAttribute(ctx, path.Root("attachments"), &attachments)
+	if ndfcCheckDiags(diags, resp) {
+		return
+	}
+	if attachments.IsNull() {
+		// attachments are not managed, e.g. by ndfc_vrf_attachment, so the
+		// status is only known after the apply if anything changes
+		return
+	}
+	status := types.MapUnknown(types.StringType)
+	if !attachments.IsUnknown() {
+		var items []VRFAttachments
+		diags = attachments.ElementsAs(ctx, &items, false)
+		if ndfcCheckDiags(diags, resp) {
+			return
+		}
+		var state VRF
+		if !req.State.Raw.IsNull() {
+			diags = req.State.Get(ctx, &state)
+			if ndfcCheckDiags(diags, resp) {
+				return
+			}
+		}
+		planned := make(map[string]VRFAttachments, len(items))
+		serials := make([]types.String, len(items))
+		for i, item := range items {
+			planned[item.SerialNumber.ValueString()] = item
+			serials[i] = item.SerialNumber
+		}
+		status = helpers.PlannedAttachmentStatus(serials, func(serialNumber string) (string, bool) {
+			item := planned[serialNumber]
+			if item.DeployConfig.ValueBool() {
+				return ndfc.StateDeployed, true
+			}
+			if item.DeployConfig.IsUnknown() || state.AttachmentStatus.IsNull() || state.AttachmentStatus.IsUnknown() {
+				return "", false
+			}
+			for _, current := range state.Attachments {
+				if ndfcEqualVrfAttachment(current, item) {
+					if v, ok := state.AttachmentStatus.Elements()[serialNumber].(types.String); ok && !v.IsUnknown() {
+						return v.ValueString(), true
+					}
+				}
+			}
+			return "", false
+		})
+	}
+	diags = resp.Plan.SetAttribute(ctx, path.Root("attachment_status"), status)
+	ndfcCheckDiags(diags, resp)
+}
+
 //template:begin import
-func (r *NdfcClient) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+func (r *VRFResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 	idParts := strings.Split(req.ID, ":")
 
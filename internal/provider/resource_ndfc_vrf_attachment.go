@@ -0,0 +1,479 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/helpers"
+	"github.com/netascode/terraform-provider-ndfc/internal/provider/ndfc"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var (
+	_ resource.Resource                = &VRFAttachmentResource{}
+	_ resource.ResourceWithImportState = &VRFAttachmentResource{}
+	_ resource.ResourceWithModifyPlan  = &VRFAttachmentResource{}
+)
+
+func NewVRFAttachmentResource() resource.Resource {
+	return &VRFAttachmentResource{}
+}
+
+type VRFAttachmentResource struct {
+	client *ndfc.Client
+}
+
+func (r *VRFAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vrf_attachment"
+}
+
+func (r *VRFAttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: helpers.NewAttributeDescription("This resource can manage the attachment of a VRF to a single switch. It can be used together with the `attachments` of the `ndfc_vrf` resource if the switch is listed in its `unmanaged_attachments`.").String,
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The id of the object",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+			"fabric_name": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("The name of the fabric").String,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vrf_name": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("The name of the VRF").String,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"serial_number": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Serial number of switch to attach").String,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vlan_id": schema.Int64Attribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Override VLAN ID. `-1` to use VLAN ID defined at VRF level").AddIntegerRangeDescription(-1, 4092).AddDefaultValueDescription("-1").String,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(-1, 4092),
+				},
+				Default: int64default.StaticInt64(-1),
+			},
+			"freeform_config": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("This field covers any configuration not included in overlay templates which is needed as part of this VRF attachment").String,
+				Optional:            true,
+			},
+			"loopback_id": schema.Int64Attribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Override loopback ID").AddIntegerRangeDescription(0, 1023).String,
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 1023),
+				},
+			},
+			"loopback_ipv4": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Override loopback IPv4 address").String,
+				Optional:            true,
+			},
+			"loopback_ipv6": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Override loopback IPv6 address").String,
+				Optional:            true,
+			},
+			"extension_values": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Extension values of the attachment as JSON string. Use `vrf_lite` to manage VRF Lite connections instead").String,
+				Optional:            true,
+			},
+			"vrf_lite": schema.ListNestedAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("VRF Lite connections of the attachment on a border switch, serialized into the extension values of the attachment").String,
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"interface_name": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Interface of the VRF Lite connection").String,
+							Required:            true,
+						},
+						"dot1q_id": schema.Int64Attribute{
+							MarkdownDescription: helpers.NewAttributeDescription("DOT1Q ID of the VRF Lite subinterface").AddIntegerRangeDescription(2, 4093).String,
+							Required:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(2, 4093),
+							},
+						},
+						"ipv4_address": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("IPv4 address of the VRF Lite subinterface with mask").String,
+							Optional:            true,
+						},
+						"neighbor_ipv4": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("IPv4 address of the neighbor").String,
+							Optional:            true,
+						},
+						"neighbor_asn": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("BGP ASN of the neighbor").String,
+							Optional:            true,
+						},
+						"ipv6_address": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("IPv6 address of the VRF Lite subinterface with mask").String,
+							Optional:            true,
+						},
+						"neighbor_ipv6": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("IPv6 address of the neighbor").String,
+							Optional:            true,
+						},
+						"peer_vrf_name": schema.StringAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Name of the VRF on the neighbor").String,
+							Optional:            true,
+						},
+						"auto_vrf_lite": schema.BoolAttribute{
+							MarkdownDescription: helpers.NewAttributeDescription("Connection was created by the auto VRF Lite of the fabric").AddDefaultValueDescription("false").String,
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ConflictsWith(path.MatchRoot("extension_values")),
+				},
+			},
+			"deploy": schema.BoolAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Deploy the attachment to the switch. If `false`, changes are only staged in NDFC and can be deployed later, e.g. with `ndfc_deploy`").AddDefaultValueDescription("true").String,
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: helpers.NewAttributeDescription("Deployment state of the attachment. If `deploy` is enabled, an attachment that is not `DEPLOYED` is deployed again on the next apply. Example: `OUT-OF-SYNC`").String,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *VRFAttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*NdfcProviderData).Client
+}
+
+// ModifyPlan plans the status expected after the apply: DEPLOYED if deploy is
+// enabled, unchanged if nothing else changes and unknown otherwise.
+func (r *VRFAttachmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan, state VRFAttachment
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	status := types.StringUnknown()
+	if plan.Deploy.ValueBool() {
+		status = types.StringValue(ndfc.StateDeployed)
+	} else if !req.State.Raw.IsNull() {
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.equal(state) {
+			status = state.Status
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), status)...)
+}
+
+// read updates data from NDFC. It returns false if the VRF or the switch no
+// longer exist or the VRF is not attached to the switch.
+func (r *VRFAttachmentResource) read(ctx context.Context, data *VRFAttachment) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	fabric, name := data.FabricName.ValueString(), data.VrfName.ValueString()
+	res, err := r.client.Get(ctx, ndfc.VRFType.Path(fabric)+name)
+	if err != nil {
+		if ndfc.IsNotFound(err) {
+			return false, diags
+		}
+		diags.Append(helpers.ClientError("Failed to retrieve object (GET)", err, nil))
+		return false, diags
+	}
+	vlan := res.Get("vrfTemplateConfig.vrfVlanId").Int()
+	res, err = r.client.GetAttachment(ctx, ndfc.VRFType, fabric, name, data.SerialNumber.ValueString())
+	if err != nil {
+		if ndfc.IsNotFound(err) {
+			return false, diags
+		}
+		diags.Append(helpers.ClientError("Failed to retrieve VRF attachments", err, nil))
+		return false, diags
+	}
+	if !res.Get("isLanAttached").Bool() {
+		return false, diags
+	}
+	data.fromBody(ctx, res, vlan)
+	return true, diags
+}
+
+// attach posts the attachment of data, or its detachment if attach is false,
+// and deploys it if deploy is enabled. It returns true if the attachment was
+// posted, even if the deployment failed.
+func (r *VRFAttachmentResource) attach(ctx context.Context, data VRFAttachment, attach bool) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	fabric, name := data.FabricName.ValueString(), data.VrfName.ValueString()
+	res, err := r.client.Post(ctx, ndfc.VRFType.Path(fabric)+"attachments", data.toBody(ctx, attach))
+	if err != nil {
+		diags.Append(helpers.ClientError("Failed to configure VRF attachments", err, data.fieldPaths()))
+		return false, diags
+	}
+	diags = helpers.CheckAttachmentResponse(ctx, res)
+	if diags.HasError() || !data.Deploy.ValueBool() {
+		return !diags.HasError(), diags
+	}
+	return true, helpers.DeployAttachment(ctx, r.client, ndfc.VRFType, fabric, name, data.SerialNumber.ValueString())
+}
+
+func (r *VRFAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan VRFAttachment
+
+	// Read plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	plan.Id = types.StringValue(plan.getId())
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Create", plan.Id.ValueString()))
+
+	res, err := r.client.GetAttachment(ctx, ndfc.VRFType, plan.FabricName.ValueString(), plan.VrfName.ValueString(), plan.SerialNumber.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(helpers.ClientError("Failed to retrieve VRF attachments", err, nil))
+		return
+	}
+	if res.Get("isLanAttached").Bool() {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("VRF %s is already attached to switch %s, import the attachment to manage it", plan.VrfName.ValueString(), plan.SerialNumber.ValueString()))
+		return
+	}
+
+	attached, diags := r.attach(ctx, plan, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		if attached {
+			// The switch is attached by now, keep the attachment in the
+			// state with its observed status so it is deployed or
+			// detached again.
+			_, diags = r.read(ctx, &plan)
+			resp.Diagnostics.Append(diags...)
+			if plan.Status.IsUnknown() {
+				plan.Status = types.StringNull()
+			}
+			resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		}
+		return
+	}
+
+	found, diags := r.read(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("VRF %s is not attached to switch %s after attaching it", plan.VrfName.ValueString(), plan.SerialNumber.ValueString()))
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Create finished successfully", plan.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *VRFAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state VRFAttachment
+
+	// Read state
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Read", state.Id.String()))
+
+	found, diags := r.read(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Read finished successfully", state.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update changes the attachment in place, the switch stays attached.
+func (r *VRFAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan VRFAttachment
+
+	// Read plan
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Update", plan.Id.ValueString()))
+
+	_, diags = r.attach(ctx, plan, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags = r.read(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Update finished successfully", plan.Id.ValueString()))
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *VRFAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state VRFAttachment
+
+	// Read state
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, helpers.NDFC_DEFAULT_TIMEOUT)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Beginning Delete", state.Id.ValueString()))
+
+	_, diags = r.attach(ctx, state, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("%s: Delete finished successfully", state.Id.ValueString()))
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *VRFAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ":")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: '<fabric_name>:<vrf_name>:<serial_number>'. Got: %q", req.ID),
+		)
+		return
+	}
+
+	data := VRFAttachment{
+		FabricName:   types.StringValue(idParts[0]),
+		VrfName:      types.StringValue(idParts[1]),
+		SerialNumber: types.StringValue(idParts[2]),
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), data.getId())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("fabric_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vrf_name"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial_number"), idParts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vlan_id"), -1)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deploy"), true)...)
+	// an empty list reads the VRF Lite connections, if any
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vrf_lite"), []VRFAttachmentsVrfLite{})...)
+}
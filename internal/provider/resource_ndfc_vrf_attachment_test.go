@@ -0,0 +1,195 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Mozilla Public License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://mozilla.org/MPL/2.0/
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNdfcVRFAttachment(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNdfcVRFAttachmentConfigMinimal,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ndfc_vrf_attachment.test", "vlan_id", "-1"),
+					resource.TestCheckResourceAttr("ndfc_vrf_attachment.test", "status", "DEPLOYED"),
+					resource.TestCheckResourceAttr("ndfc_vrf.test", "attachment_status.9DBYO6WQJ46", "DEPLOYED"),
+				),
+			},
+			{
+				Config: testAccNdfcVRFAttachmentConfigAll,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ndfc_vrf_attachment.test", "fabric_name", "CML"),
+					resource.TestCheckResourceAttr("ndfc_vrf_attachment.test", "vrf_name", "VRF1"),
+					resource.TestCheckResourceAttr("ndfc_vrf_attachment.test", "serial_number", "9DBYO6WQJ46"),
+					resource.TestCheckResourceAttr("ndfc_vrf_attachment.test", "vlan_id", "2000"),
+					resource.TestCheckResourceAttr("ndfc_vrf_attachment.test", "freeform_config", "ip route 0.0.0.0/0 192.0.2.1"),
+					resource.TestCheckResourceAttr("ndfc_vrf_attachment.test", "loopback_id", "101"),
+					resource.TestCheckResourceAttr("ndfc_vrf_attachment.test", "loopback_ipv4", "198.51.100.1"),
+					resource.TestCheckResourceAttr("ndfc_vrf_attachment.test", "status", "DEPLOYED"),
+				),
+			},
+			{
+				ResourceName:            "ndfc_vrf_attachment.test",
+				ImportState:             true,
+				ImportStateId:           "CML:VRF1:9DBYO6WQJ46",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
+
+const testAccNdfcVRFAttachmentConfigMinimal = `
+resource "ndfc_vrf" "test" {
+	fabric_name = "CML"
+	vrf_name = "VRF1"
+}
+
+resource "ndfc_vrf_attachment" "test" {
+	fabric_name = "CML"
+	vrf_name = ndfc_vrf.test.vrf_name
+	serial_number = "9DBYO6WQJ46"
+}
+`
+
+const testAccNdfcVRFAttachmentConfigAll = `
+resource "ndfc_vrf" "test" {
+	fabric_name = "CML"
+	vrf_name = "VRF1"
+}
+
+resource "ndfc_vrf_attachment" "test" {
+	fabric_name = "CML"
+	vrf_name = ndfc_vrf.test.vrf_name
+	serial_number = "9DBYO6WQJ46"
+	vlan_id = 2000
+	freeform_config = "ip route 0.0.0.0/0 192.0.2.1"
+	loopback_id = 101
+	loopback_ipv4 = "198.51.100.1"
+}
+`
+
+func TestAccNdfcVRFAttachmentVrfLite(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNdfcVRFAttachmentConfigVrfLite,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ndfc_vrf_attachment.test", "vrf_lite.#", "1"),
+					resource.TestCheckResourceAttr("ndfc_vrf_attachment.test", "vrf_lite.0.interface_name", "Ethernet1/10"),
+					resource.TestCheckResourceAttr("ndfc_vrf_attachment.test", "vrf_lite.0.dot1q_id", "2"),
+					resource.TestCheckResourceAttr("ndfc_vrf_attachment.test", "vrf_lite.0.ipv4_address", "10.33.0.2/30"),
+					resource.TestCheckResourceAttr("ndfc_vrf_attachment.test", "vrf_lite.0.neighbor_ipv4", "10.33.0.1"),
+					resource.TestCheckResourceAttr("ndfc_vrf_attachment.test", "vrf_lite.0.neighbor_asn", "65001"),
+					resource.TestCheckResourceAttr("ndfc_vrf_attachment.test", "vrf_lite.0.auto_vrf_lite", "false"),
+					resource.TestCheckResourceAttr("ndfc_vrf_attachment.test", "status", "DEPLOYED"),
+				),
+			},
+			{
+				ResourceName:            "ndfc_vrf_attachment.test",
+				ImportState:             true,
+				ImportStateId:           "CML:VRF1:9DBYO6WQJ46",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			{
+				Config: testAccNdfcVRFAttachmentConfigMinimal,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("ndfc_vrf_attachment.test", "vrf_lite.#"),
+					resource.TestCheckResourceAttr("ndfc_vrf_attachment.test", "status", "DEPLOYED"),
+				),
+			},
+		},
+	})
+}
+
+const testAccNdfcVRFAttachmentConfigVrfLite = `
+resource "ndfc_vrf" "test" {
+	fabric_name = "CML"
+	vrf_name = "VRF1"
+}
+
+resource "ndfc_vrf_attachment" "test" {
+	fabric_name = "CML"
+	vrf_name = ndfc_vrf.test.vrf_name
+	serial_number = "9DBYO6WQJ46"
+	vrf_lite = [{
+		interface_name = "Ethernet1/10"
+		dot1q_id = 2
+		ipv4_address = "10.33.0.2/30"
+		neighbor_ipv4 = "10.33.0.1"
+		neighbor_asn = "65001"
+	}]
+}
+`
+
+func TestAccNdfcVRFAttachmentWithVRFAttachments(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNdfcVRFAttachmentConfigWithVRFAttachments(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ndfc_vrf.test", "attachments.#", "1"),
+					resource.TestCheckResourceAttr("ndfc_vrf.test", "attachment_status.%", "1"),
+					resource.TestCheckResourceAttr("ndfc_vrf.test", "attachment_status.9RB5Y9BFNTU", "DEPLOYED"),
+					resource.TestCheckResourceAttr("ndfc_vrf_attachment.test", "status", "DEPLOYED"),
+				),
+			},
+			{
+				Config: testAccNdfcVRFAttachmentConfigWithVRFAttachments(`freeform_config = "ip route 0.0.0.0/0 192.0.2.1"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ndfc_vrf.test", "attachments.#", "1"),
+					resource.TestCheckResourceAttr("ndfc_vrf.test", "attachments.0.freeform_config", "ip route 0.0.0.0/0 192.0.2.1"),
+					resource.TestCheckResourceAttr("ndfc_vrf.test", "attachment_status.%", "1"),
+					resource.TestCheckResourceAttr("ndfc_vrf_attachment.test", "status", "DEPLOYED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNdfcVRFAttachmentConfigWithVRFAttachments(attributes string) string {
+	return `
+resource "ndfc_vrf" "test" {
+	fabric_name = "CML"
+	vrf_name = "VRF1"
+	attachments = [{
+		serial_number = "9RB5Y9BFNTU"
+		deploy_config = true
+		` + attributes + `
+	}]
+	unmanaged_attachments = ["9DBYO6WQJ46"]
+}
+
+resource "ndfc_vrf_attachment" "test" {
+	fabric_name = "CML"
+	vrf_name = ndfc_vrf.test.vrf_name
+	serial_number = "9DBYO6WQJ46"
+}
+`
+}
@@ -154,3 +154,147 @@ resource "ndfc_vrf" "test" {
 `
 
 //template:end testAccConfigAll
+
+func TestAccNdfcVRFAttachmentUpdate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNdfcVRFConfigAttachments(`{
+		serial_number = "9DBYO6WQJ46"
+		deploy_config = true
+	}, {
+		serial_number = "9RB5Y9BFNTU"
+		deploy_config = true
+	}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ndfc_vrf.test", "attachments.#", "2"),
+					resource.TestCheckResourceAttr("ndfc_vrf.test", "attachment_status.9DBYO6WQJ46", "DEPLOYED"),
+					resource.TestCheckResourceAttr("ndfc_vrf.test", "attachment_status.9RB5Y9BFNTU", "DEPLOYED"),
+				),
+			},
+			{
+				Config: testAccNdfcVRFConfigAttachments(`{
+		serial_number = "9DBYO6WQJ46"
+		deploy_config = true
+		freeform_config = "ip route 0.0.0.0/0 192.0.2.1"
+	}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ndfc_vrf.test", "attachments.#", "1"),
+					resource.TestCheckResourceAttr("ndfc_vrf.test", "attachments.0.freeform_config", "ip route 0.0.0.0/0 192.0.2.1"),
+					resource.TestCheckResourceAttr("ndfc_vrf.test", "attachment_status.%", "1"),
+					resource.TestCheckResourceAttr("ndfc_vrf.test", "attachment_status.9DBYO6WQJ46", "DEPLOYED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNdfcVRFVrfLite(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNdfcVRFConfigAttachments(`{
+		serial_number = "9DBYO6WQJ46"
+		deploy_config = true
+		vrf_lite = [{
+			interface_name = "Ethernet1/10"
+			dot1q_id = 2
+			ipv4_address = "10.33.0.2/30"
+			neighbor_ipv4 = "10.33.0.1"
+			neighbor_asn = "65001"
+		}]
+	}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ndfc_vrf.test", "attachments.0.vrf_lite.#", "1"),
+					resource.TestCheckResourceAttr("ndfc_vrf.test", "attachments.0.vrf_lite.0.interface_name", "Ethernet1/10"),
+					resource.TestCheckResourceAttr("ndfc_vrf.test", "attachments.0.vrf_lite.0.dot1q_id", "2"),
+					resource.TestCheckResourceAttr("ndfc_vrf.test", "attachments.0.vrf_lite.0.neighbor_asn", "65001"),
+					resource.TestCheckResourceAttr("ndfc_vrf.test", "attachment_status.9DBYO6WQJ46", "DEPLOYED"),
+				),
+			},
+			{
+				Config: testAccNdfcVRFConfigAttachments(`{
+		serial_number = "9DBYO6WQJ46"
+		deploy_config = true
+	}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("ndfc_vrf.test", "attachments.0.vrf_lite.#"),
+					resource.TestCheckResourceAttr("ndfc_vrf.test", "attachment_status.9DBYO6WQJ46", "DEPLOYED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNdfcVRFAttachmentDrift(t *testing.T) {
+	path := "/lan-fabric/rest/top-down/v2/fabrics/CML/vrfs/attachments"
+	config := testAccNdfcVRFConfigAttachments(`{
+		serial_number = "9DBYO6WQJ46"
+		deploy_config = true
+	}`)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("ndfc_vrf.test", "attachment_status.9DBYO6WQJ46", "DEPLOYED"),
+			},
+			{
+				PreConfig: func() {
+					testAccPost(t, path, `[{"vrfName":"VRF1","lanAttachList":[{"fabric":"CML","vrfName":"VRF1","serialNumber":"9RB5Y9BFNTU","vlan":-1,"deployment":true}]}]`)
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ndfc_vrf.test", "attachments.#", "1"),
+					resource.TestCheckResourceAttr("ndfc_vrf.test", "attachment_status.%", "1"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccPost(t, path, `[{"vrfName":"VRF1","lanAttachList":[{"fabric":"CML","vrfName":"VRF1","serialNumber":"9DBYO6WQJ46","deployment":false}]}]`)
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("ndfc_vrf.test", "attachment_status.9DBYO6WQJ46", "DEPLOYED"),
+			},
+			{
+				PreConfig: func() {
+					testAccSetAttachmentState(t, "vrfs", "VRF1", "9DBYO6WQJ46", "OUT-OF-SYNC")
+				},
+				SkipFunc:           testAccSkipWithoutMock,
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				SkipFunc: testAccSkipWithoutMock,
+				Config:   config,
+				Check:    resource.TestCheckResourceAttr("ndfc_vrf.test", "attachment_status.9DBYO6WQJ46", "DEPLOYED"),
+			},
+		},
+	})
+}
+
+func testAccNdfcVRFConfigAttachments(attachments string) string {
+	return `
+resource "ndfc_vrf" "test" {
+	fabric_name = "CML"
+	vrf_name = "VRF1"
+	attachments = [` + attachments + `]
+}
+`
+}